@@ -0,0 +1,84 @@
+package spectrum
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+type CatAuditLogInst struct {
+	AuditSeqNo   string `json:"audit_seq_no,omitempty"`
+	Timestamp    string `json:"timestamp,omitempty"`
+	ClusterUser  string `json:"cluster_user,omitempty"`
+	SshIpAddress string `json:"ssh_ip_address,omitempty"`
+	Result       string `json:"result,omitempty"`
+	ResObjId     string `json:"res_obj_id,omitempty"`
+	ActionCmd    string `json:"action_cmd,omitempty"`
+}
+
+type CatAuditLogRequest struct {
+	First string `json:"first,omitempty"`
+}
+
+// PostCatAuditLog
+// first 가 0 이면 메모리에 남아있는 모든 감사 로그를 조회합니다.
+func (c *Client) PostCatAuditLog(first int) []*CatAuditLogInst {
+	var jsonReq []byte
+	if first > 0 {
+		var reqBody CatAuditLogRequest
+		reqBody.First = strconv.Itoa(first)
+		jsonReq, _ = json.Marshal(reqBody)
+	}
+	body, err := c.post("/rest/catauditlog", jsonReq)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*CatAuditLogInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+func (_a *CatAuditLogInst) SeqNo() int {
+	seq, err := strconv.Atoi(_a.AuditSeqNo)
+	if err != nil {
+		return -1
+	}
+	return seq
+}
+
+// Time
+// timestamp (YYMMDDHHMMSS) 를 시스템 시간대(loc, Client.Location 참고)로 변환합니다.
+func (_a *CatAuditLogInst) Time(loc *time.Location) (time.Time, error) {
+	return time.ParseInLocation("060102150405", _a.Timestamp, loc)
+}
+
+type LsAuditLogDumpsInst struct {
+	Id               string `json:"id,omitempty"`
+	AuditlogFilename string `json:"auditlog_filename,omitempty"`
+}
+
+func (c *Client) PostLsAuditLogDumps() []*LsAuditLogDumpsInst {
+	body, err := c.post("/rest/lsauditlogdumps", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*LsAuditLogDumpsInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
@@ -0,0 +1,67 @@
+package spectrum
+
+import "encoding/json"
+
+type LsArrayInst struct {
+	MdiskId      string      `json:"mdisk_id,omitempty"`
+	MdiskName    string      `json:"mdisk_name,omitempty"`
+	Status       MdiskStatus `json:"status,omitempty"`
+	MdiskGrpId   string      `json:"mdisk_grp_id,omitempty"`
+	MdiskGrpName string      `json:"mdisk_grp_name,omitempty"`
+	Capacity     string      `json:"capacity,omitempty"`
+	RaidStatus   RaidStatus  `json:"raid_status,omitempty"`
+	RaidLevel    string      `json:"raid_level,omitempty"`
+	Redundancy   String      `json:"redundancy,omitempty"`
+	StripSize    string      `json:"strip_size,omitempty"`
+	Tier         string      `json:"tier,omitempty"`
+	Encrypt      string      `json:"encrypt,omitempty"`
+	Distributed  string      `json:"distributed,omitempty"`
+}
+
+func (c *Client) PostLsArray() []*LsArrayInst {
+	body, err := c.post("/rest/lsarray", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*LsArrayInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+type RaidStatus string
+
+const (
+	RaidStatusEnumOnline    RaidStatus = "online"
+	RaidStatusEnumDegraded  RaidStatus = "degraded"
+	RaidStatusEnumOffline   RaidStatus = "offline"
+	RaidStatusEnumSyncing   RaidStatus = "syncing"
+	RaidStatusEnumInitting  RaidStatus = "initting"
+	RaidStatusEnumExpanding RaidStatus = "expanding"
+)
+
+func (_rs RaidStatus) Float64() float64 {
+	switch _rs {
+	case RaidStatusEnumOnline:
+		return 0.0
+	case RaidStatusEnumDegraded:
+		return 1.0
+	case RaidStatusEnumOffline:
+		return 2.0
+	case RaidStatusEnumSyncing:
+		return 3.0
+	case RaidStatusEnumInitting:
+		return 4.0
+	case RaidStatusEnumExpanding:
+		return 5.0
+	default:
+		return -1.0
+	}
+}
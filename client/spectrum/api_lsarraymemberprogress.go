@@ -0,0 +1,44 @@
+package spectrum
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// LsArrayMemberProgressInst
+// 멤버 드라이브의 rebuild / exchange / copyback 진행 상태
+type LsArrayMemberProgressInst struct {
+	MdiskId                 string `json:"mdisk_id,omitempty"`
+	MdiskName               string `json:"mdisk_name,omitempty"`
+	MemberId                string `json:"member_id,omitempty"`
+	DriveId                 string `json:"drive_id,omitempty"`
+	Task                    string `json:"task,omitempty"`
+	CurrentStep             String `json:"current_step,omitempty"`
+	TotalSteps              String `json:"total_steps,omitempty"`
+	Progress                String `json:"progress,omitempty"`
+	EstimatedCompletionTime String `json:"estimated_completion_time,omitempty"`
+}
+
+func (c *Client) PostLsArrayMemberProgress() []*LsArrayMemberProgressInst {
+	body, err := c.post("/rest/lsarraymemberprogress", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*LsArrayMemberProgressInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+// EstimatedCompletion
+// estimated_completion_time (YYMMDDHHMMSS) 을 시스템 시간대(loc, Client.Location 참고)로 변환
+func (_a *LsArrayMemberProgressInst) EstimatedCompletion(loc *time.Location) (time.Time, error) {
+	return time.ParseInLocation("060102150405", string(_a.EstimatedCompletionTime), loc)
+}
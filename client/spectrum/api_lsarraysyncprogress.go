@@ -0,0 +1,37 @@
+package spectrum
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type LsArraySyncProgressInst struct {
+	MdiskId                 string `json:"mdisk_id,omitempty"`
+	MdiskName               string `json:"mdisk_name,omitempty"`
+	Progress                String `json:"progress,omitempty"`
+	EstimatedCompletionTime String `json:"estimated_completion_time,omitempty"`
+}
+
+func (c *Client) PostLsArraySyncProgress() []*LsArraySyncProgressInst {
+	body, err := c.post("/rest/lsarraysyncprogress", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*LsArraySyncProgressInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+// EstimatedCompletion
+// estimated_completion_time (YYMMDDHHMMSS) 을 시스템 시간대(loc, Client.Location 참고)로 변환
+func (_a *LsArraySyncProgressInst) EstimatedCompletion(loc *time.Location) (time.Time, error) {
+	return time.ParseInLocation("060102150405", string(_a.EstimatedCompletionTime), loc)
+}
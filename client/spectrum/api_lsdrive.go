@@ -0,0 +1,89 @@
+package spectrum
+
+import "encoding/json"
+
+type LsDriveInst struct {
+	Id                  string          `json:"id,omitempty"`
+	Status              ComponentStatus `json:"status,omitempty"`
+	ErrorSequenceNumber string          `json:"error_sequence_number,omitempty"`
+	Use                 DriveUse        `json:"use,omitempty"`
+	TechType            string          `json:"tech_type,omitempty"`
+	Capacity            string          `json:"capacity,omitempty"`
+	MdiskId             string          `json:"mdisk_id,omitempty"`
+	MdiskName           string          `json:"mdisk_name,omitempty"`
+	MemberId            string          `json:"member_id,omitempty"`
+	EnclosureId         string          `json:"enclosure_id,omitempty"`
+	SlotId              string          `json:"slot_id,omitempty"`
+	NodeId              string          `json:"node_id,omitempty"`
+	NodeName            string          `json:"node_name,omitempty"`
+	AutoManage          string          `json:"auto_manage,omitempty"`
+	DriveClassId        string          `json:"drive_class_id,omitempty"`
+	FRUPartNumber       string          `json:"FRU_part_number,omitempty"`
+	FRUIdentity         string          `json:"FRU_identity,omitempty"`
+}
+
+func (c *Client) PostLsDrive() []*LsDriveInst {
+	body, err := c.post("/rest/lsdrive", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*LsDriveInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+// PostLsDriveDetail
+// 목록(concise) 조회에는 FRU_part_number, FRU_identity 가 포함되지 않으므로,
+// drive 별 상세 조회로 가져옵니다.
+func (c *Client) PostLsDriveDetail(id string) *LsDriveInst {
+	body, err := c.post("/rest/lsdrive/"+id, nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data LsDriveInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return &data
+}
+
+type DriveUse string
+
+const (
+	DriveUseEnumUnused    DriveUse = "unused"
+	DriveUseEnumCandidate DriveUse = "candidate"
+	DriveUseEnumSpare     DriveUse = "spare"
+	DriveUseEnumMember    DriveUse = "member"
+	DriveUseEnumFailed    DriveUse = "failed"
+)
+
+func (_du DriveUse) Float64() float64 {
+	switch _du {
+	case DriveUseEnumUnused:
+		return 0.0
+	case DriveUseEnumCandidate:
+		return 1.0
+	case DriveUseEnumSpare:
+		return 2.0
+	case DriveUseEnumMember:
+		return 3.0
+	case DriveUseEnumFailed:
+		return 4.0
+	default:
+		return -1.0
+	}
+}
@@ -0,0 +1,75 @@
+package spectrum
+
+import "encoding/json"
+
+type LsDumpsInst struct {
+	Id       string `json:"id,omitempty"`
+	Filename string `json:"filename,omitempty"`
+}
+
+type LsDumpsRequest struct {
+	Prefix string `json:"prefix,omitempty"`
+}
+
+// PostLsDumps
+// nodeId 가 비어있으면 config node 의 파일 목록을 조회합니다.
+func (c *Client) PostLsDumps(prefix string, nodeId string) []*LsDumpsInst {
+	var reqBody LsDumpsRequest
+	reqBody.Prefix = prefix
+	jsonReq, _ := json.Marshal(reqBody)
+	path := "/rest/lsdumps"
+	if nodeId != "" {
+		path += "/" + nodeId
+	}
+	body, err := c.post(path, jsonReq)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*LsDumpsInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+// PostCpDumps
+// nodeId 노드의 파일을 config node 로 복사합니다. (prefix 는 파일 전체 경로)
+func (c *Client) PostCpDumps(prefix string, nodeId string) error {
+	var reqBody LsDumpsRequest
+	reqBody.Prefix = prefix
+	jsonReq, _ := json.Marshal(reqBody)
+	_, err := c.post("/rest/cpdumps/"+nodeId, jsonReq)
+	if err != nil {
+		c.lastAuth = false
+		return err
+	}
+
+	return nil
+}
+
+type DownloadRequest struct {
+	Prefix   string `json:"prefix"`
+	Filename string `json:"filename"`
+}
+
+// PostDownload
+// config node 의 prefix 디렉토리에 있는 파일을 다운로드합니다.
+func (c *Client) PostDownload(prefix string, filename string) ([]byte, error) {
+	var reqBody DownloadRequest
+	reqBody.Prefix = prefix
+	reqBody.Filename = filename
+	jsonReq, _ := json.Marshal(reqBody)
+	body, err := c.post("/rest/download", jsonReq)
+	if err != nil {
+		c.lastAuth = false
+		return nil, err
+	}
+
+	return body, nil
+}
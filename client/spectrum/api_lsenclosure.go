@@ -0,0 +1,82 @@
+package spectrum
+
+import "encoding/json"
+
+type LsEnclosureInst struct {
+	Id               string          `json:"id,omitempty"`
+	Status           ComponentStatus `json:"status,omitempty"`
+	Type             string          `json:"type,omitempty"`
+	Managed          string          `json:"managed,omitempty"`
+	IOGroupId        string          `json:"IO_group_id,omitempty"`
+	IOGroupName      string          `json:"IO_group_name,omitempty"`
+	ProductMTM       string          `json:"product_MTM,omitempty"`
+	SerialNumber     string          `json:"serial_number,omitempty"`
+	TotalCanisters   String          `json:"total_canisters,omitempty"`
+	OnlineCanisters  String          `json:"online_canisters,omitempty"`
+	TotalPSUs        String          `json:"total_PSUs,omitempty"`
+	OnlinePSUs       String          `json:"online_PSUs,omitempty"`
+	DriveSlots       String          `json:"drive_slots,omitempty"`
+	TotalFanModules  String          `json:"total_fan_modules,omitempty"`
+	OnlineFanModules String          `json:"online_fan_modules,omitempty"`
+	TotalSems        String          `json:"total_sems,omitempty"`
+	OnlineSems       String          `json:"online_sems,omitempty"`
+}
+
+func (c *Client) PostLsEnclosure() []*LsEnclosureInst {
+	body, err := c.post("/rest/lsenclosure", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*LsEnclosureInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+// ComponentStatus
+// node canister, enclosure, drive, psu, battery 등 하드웨어 구성요소의 상태
+type ComponentStatus string
+
+const (
+	ComponentStatusEnumOnline   ComponentStatus = "online"
+	ComponentStatusEnumDegraded ComponentStatus = "degraded"
+	ComponentStatusEnumOffline  ComponentStatus = "offline"
+	ComponentStatusEnumService  ComponentStatus = "service"
+	ComponentStatusEnumFlushing ComponentStatus = "flushing"
+	ComponentStatusEnumPending  ComponentStatus = "pending"
+	ComponentStatusEnumAdding   ComponentStatus = "adding"
+	ComponentStatusEnumDeleting ComponentStatus = "deleting"
+	ComponentStatusEnumExcluded ComponentStatus = "excluded"
+)
+
+func (_cs ComponentStatus) Float64() float64 {
+	switch _cs {
+	case ComponentStatusEnumOnline:
+		return 0.0
+	case ComponentStatusEnumDegraded:
+		return 1.0
+	case ComponentStatusEnumOffline:
+		return 2.0
+	case ComponentStatusEnumService:
+		return 3.0
+	case ComponentStatusEnumFlushing:
+		return 4.0
+	case ComponentStatusEnumPending:
+		return 5.0
+	case ComponentStatusEnumAdding:
+		return 6.0
+	case ComponentStatusEnumDeleting:
+		return 7.0
+	case ComponentStatusEnumExcluded:
+		return 8.0
+	default:
+		return -1.0
+	}
+}
@@ -0,0 +1,63 @@
+package spectrum
+
+import "encoding/json"
+
+type LsEnclosureBatteryInst struct {
+	EnclosureId       string          `json:"enclosure_id,omitempty"`
+	BatteryId         string          `json:"battery_id,omitempty"`
+	Status            ComponentStatus `json:"status,omitempty"`
+	ChargingStatus    string          `json:"charging_status,omitempty"`
+	ReconditionNeeded YesNo           `json:"recondition_needed,omitempty"`
+	PercentCharged    String          `json:"percent_charged,omitempty"`
+	EndOfLifeWarning  YesNo           `json:"end_of_life_warning,omitempty"`
+	CanisterId        string          `json:"canister_id,omitempty"`
+	BatterySlot       string          `json:"battery_slot,omitempty"`
+	FRUPartNumber     string          `json:"FRU_part_number,omitempty"`
+	FRUIdentity       string          `json:"FRU_identity,omitempty"`
+}
+
+func (c *Client) PostLsEnclosureBattery() []*LsEnclosureBatteryInst {
+	body, err := c.post("/rest/lsenclosurebattery", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*LsEnclosureBatteryInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+type LsEnclosureBatteryRequest struct {
+	Battery string `json:"battery,omitempty"`
+}
+
+// PostLsEnclosureBatteryDetail
+// 목록(concise) 조회에는 FRU_part_number, FRU_identity 가 포함되지 않으므로,
+// battery 별 상세 조회로 가져옵니다.
+func (c *Client) PostLsEnclosureBatteryDetail(enclosureId string, batteryId string) *LsEnclosureBatteryInst {
+	var reqBody LsEnclosureBatteryRequest
+	reqBody.Battery = batteryId
+	jsonReq, _ := json.Marshal(reqBody)
+	body, err := c.post("/rest/lsenclosurebattery/"+enclosureId, jsonReq)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data LsEnclosureBatteryInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return &data
+}
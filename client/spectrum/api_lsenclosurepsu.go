@@ -0,0 +1,58 @@
+package spectrum
+
+import "encoding/json"
+
+type LsEnclosurePSUInst struct {
+	EnclosureId   string          `json:"enclosure_id,omitempty"`
+	PSUId         string          `json:"PSU_id,omitempty"`
+	Status        ComponentStatus `json:"status,omitempty"`
+	InputPower    string          `json:"input_power,omitempty"`
+	FRUPartNumber string          `json:"FRU_part_number,omitempty"`
+	FRUIdentity   string          `json:"FRU_identity,omitempty"`
+}
+
+func (c *Client) PostLsEnclosurePSU() []*LsEnclosurePSUInst {
+	body, err := c.post("/rest/lsenclosurepsu", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*LsEnclosurePSUInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+type LsEnclosurePSURequest struct {
+	PSU string `json:"psu,omitempty"`
+}
+
+// PostLsEnclosurePSUDetail
+// 목록(concise) 조회에는 FRU_part_number, FRU_identity 가 포함되지 않으므로,
+// PSU 별 상세 조회로 가져옵니다.
+func (c *Client) PostLsEnclosurePSUDetail(enclosureId string, psuId string) *LsEnclosurePSUInst {
+	var reqBody LsEnclosurePSURequest
+	reqBody.PSU = psuId
+	jsonReq, _ := json.Marshal(reqBody)
+	body, err := c.post("/rest/lsenclosurepsu/"+enclosureId, jsonReq)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data LsEnclosurePSUInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return &data
+}
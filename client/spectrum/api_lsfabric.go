@@ -0,0 +1,35 @@
+package spectrum
+
+import "encoding/json"
+
+type LsFabricInst struct {
+	RemoteWWPN    string `json:"remote_wwpn,omitempty"`
+	RemoteNportId string `json:"remote_nportid,omitempty"`
+	Id            string `json:"id,omitempty"`
+	NodeName      string `json:"node_name,omitempty"`
+	LocalWWPN     string `json:"local_wwpn,omitempty"`
+	LocalPort     string `json:"local_port,omitempty"`
+	LocalNportId  string `json:"local_nportid,omitempty"`
+	State         string `json:"state,omitempty"`
+	Name          string `json:"name,omitempty"`
+	ClusterName   string `json:"cluster_name,omitempty"`
+	Type          string `json:"type,omitempty"`
+}
+
+func (c *Client) PostLsFabric() []*LsFabricInst {
+	body, err := c.post("/rest/lsfabric", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*LsFabricInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
@@ -0,0 +1,31 @@
+package spectrum
+
+import "encoding/json"
+
+type LsFcConsistGrpInst struct {
+	Id         string          `json:"id,omitempty"`
+	Name       string          `json:"name,omitempty"`
+	Status     FlashCopyStatus `json:"status,omitempty"`
+	StartTime  String          `json:"start_time,omitempty"`
+	Autodelete string          `json:"autodelete,omitempty"`
+	OwnerId    string          `json:"owner_id,omitempty"`
+	OwnerName  string          `json:"owner_name,omitempty"`
+}
+
+func (c *Client) PostLsFcConsistGrp() []*LsFcConsistGrpInst {
+	body, err := c.post("/rest/lsfcconsistgrp", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*LsFcConsistGrpInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
@@ -52,6 +52,7 @@ const (
 	FlashCopyStatusEnumStopped      FlashCopyStatus = "stopped"
 	FlashCopyStatusEnumStopping     FlashCopyStatus = "stopping"
 	FlashCopyStatusEnumSuspended    FlashCopyStatus = "suspended"
+	FlashCopyStatusEnumEmpty        FlashCopyStatus = "empty"
 )
 
 func (_fc FlashCopyStatus) Float64() float64 {
@@ -70,6 +71,8 @@ func (_fc FlashCopyStatus) Float64() float64 {
 		return 5.0
 	case FlashCopyStatusEnumSuspended:
 		return 6.0
+	case FlashCopyStatusEnumEmpty:
+		return 7.0
 	default:
 		return -1.0
 	}
@@ -86,6 +89,12 @@ func (_s String) Float64() float64 {
 }
 
 func (_s String) Time2Float64() float64 {
-	t, _ := time.Parse("060102150405", string(_s))
+	return _s.Time2Float64In(time.UTC)
+}
+
+// Time2Float64In
+// YYMMDDHHMMSS 를 시스템 시간대(loc, Client.Location 참고)로 변환합니다.
+func (_s String) Time2Float64In(loc *time.Location) float64 {
+	t, _ := time.ParseInLocation("060102150405", string(_s), loc)
 	return float64(t.Unix())
 }
@@ -0,0 +1,62 @@
+package spectrum
+
+import "encoding/json"
+
+type LsHostInst struct {
+	Id              string     `json:"id,omitempty"`
+	Name            string     `json:"name,omitempty"`
+	PortCount       String     `json:"port_count,omitempty"`
+	IogrpCount      String     `json:"iogrp_count,omitempty"`
+	Status          HostStatus `json:"status,omitempty"`
+	SiteId          string     `json:"site_id,omitempty"`
+	SiteName        string     `json:"site_name,omitempty"`
+	HostClusterId   string     `json:"host_cluster_id,omitempty"`
+	HostClusterName string     `json:"host_cluster_name,omitempty"`
+	Protocol        string     `json:"protocol,omitempty"`
+	OwnerId         string     `json:"owner_id,omitempty"`
+	OwnerName       string     `json:"owner_name,omitempty"`
+	PortsetId       string     `json:"portset_id,omitempty"`
+	PortsetName     string     `json:"portset_name,omitempty"`
+}
+
+func (c *Client) PostLsHost() []*LsHostInst {
+	body, err := c.post("/rest/lshost", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*LsHostInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+type HostStatus string
+
+const (
+	HostStatusEnumOnline   HostStatus = "online"
+	HostStatusEnumDegraded HostStatus = "degraded"
+	HostStatusEnumOffline  HostStatus = "offline"
+	HostStatusEnumExcluded HostStatus = "excluded"
+)
+
+func (_hs HostStatus) Float64() float64 {
+	switch _hs {
+	case HostStatusEnumOnline:
+		return 0.0
+	case HostStatusEnumDegraded:
+		return 1.0
+	case HostStatusEnumOffline:
+		return 2.0
+	case HostStatusEnumExcluded:
+		return 3.0
+	default:
+		return -1.0
+	}
+}
@@ -0,0 +1,32 @@
+package spectrum
+
+import "encoding/json"
+
+type LsHostClusterInst struct {
+	Id           string     `json:"id,omitempty"`
+	Name         string     `json:"name,omitempty"`
+	Status       HostStatus `json:"status,omitempty"`
+	HostCount    String     `json:"host_count,omitempty"`
+	MappingCount String     `json:"mapping_count,omitempty"`
+	PortCount    String     `json:"port_count,omitempty"`
+	OwnerId      string     `json:"owner_id,omitempty"`
+	OwnerName    string     `json:"owner_name,omitempty"`
+}
+
+func (c *Client) PostLsHostCluster() []*LsHostClusterInst {
+	body, err := c.post("/rest/lshostcluster", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*LsHostClusterInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
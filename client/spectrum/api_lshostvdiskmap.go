@@ -0,0 +1,36 @@
+package spectrum
+
+import "encoding/json"
+
+type LsHostVdiskMapInst struct {
+	Id              string `json:"id,omitempty"`
+	Name            string `json:"name,omitempty"`
+	SCSIId          string `json:"SCSI_id,omitempty"`
+	VdiskId         string `json:"vdisk_id,omitempty"`
+	VdiskName       string `json:"vdisk_name,omitempty"`
+	VdiskUID        string `json:"vdisk_UID,omitempty"`
+	IOGroupId       string `json:"IO_group_id,omitempty"`
+	IOGroupName     string `json:"IO_group_name,omitempty"`
+	MappingType     string `json:"mapping_type,omitempty"`
+	HostClusterId   string `json:"host_cluster_id,omitempty"`
+	HostClusterName string `json:"host_cluster_name,omitempty"`
+	Protocol        string `json:"protocol,omitempty"`
+}
+
+func (c *Client) PostLsHostVdiskMap() []*LsHostVdiskMapInst {
+	body, err := c.post("/rest/lshostvdiskmap", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*LsHostVdiskMapInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
@@ -0,0 +1,31 @@
+package spectrum
+
+import "encoding/json"
+
+type LsIOGrpInst struct {
+	Id         string `json:"id,omitempty"`
+	Name       string `json:"name,omitempty"`
+	NodeCount  String `json:"node_count,omitempty"`
+	VdiskCount String `json:"vdisk_count,omitempty"`
+	HostCount  String `json:"host_count,omitempty"`
+	SiteId     string `json:"site_id,omitempty"`
+	SiteName   string `json:"site_name,omitempty"`
+}
+
+func (c *Client) PostLsIOGrp() []*LsIOGrpInst {
+	body, err := c.post("/rest/lsiogrp", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*LsIOGrpInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
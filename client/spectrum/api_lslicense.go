@@ -0,0 +1,56 @@
+package spectrum
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// LsLicenseInst
+// 모델/코드 레벨에 따라 항목이 다르므로 license_<feature>, used_<feature> 형태의 값을 그대로 보관합니다.
+type LsLicenseInst map[string]string
+
+type LicenseUsage struct {
+	Feature  string
+	Licensed String
+	Used     String
+}
+
+func (c *Client) PostLsLicense() LsLicenseInst {
+	body, err := c.post("/rest/lslicense", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data LsLicenseInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+// Usages
+// license_<feature> 와 used_<feature> 를 feature 별로 묶어 리턴합니다.
+func (_l LsLicenseInst) Usages() []*LicenseUsage {
+	var usages []*LicenseUsage
+	for k, v := range _l {
+		feature, ok := strings.CutPrefix(k, "license_")
+		if !ok {
+			continue
+		}
+		used, ok := _l["used_"+feature]
+		if !ok {
+			continue
+		}
+		usages = append(usages, &LicenseUsage{
+			Feature:  feature,
+			Licensed: String(v),
+			Used:     String(used),
+		})
+	}
+	return usages
+}
@@ -0,0 +1,68 @@
+package spectrum
+
+import "encoding/json"
+
+type LsMdiskInst struct {
+	Id              string      `json:"id,omitempty"`
+	Name            string      `json:"name,omitempty"`
+	Status          MdiskStatus `json:"status,omitempty"`
+	Mode            string      `json:"mode,omitempty"`
+	MdiskGrpId      string      `json:"mdisk_grp_id,omitempty"`
+	MdiskGrpName    string      `json:"mdisk_grp_name,omitempty"`
+	Capacity        string      `json:"capacity,omitempty"`
+	CtrlLUN         string      `json:"ctrl_LUN_#,omitempty"`
+	ControllerName  string      `json:"controller_name,omitempty"`
+	UID             string      `json:"UID,omitempty"`
+	Tier            string      `json:"tier,omitempty"`
+	Encrypt         string      `json:"encrypt,omitempty"`
+	SiteId          string      `json:"site_id,omitempty"`
+	SiteName        string      `json:"site_name,omitempty"`
+	Distributed     string      `json:"distributed,omitempty"`
+	Dedupe          string      `json:"dedupe,omitempty"`
+	OverProvisioned string      `json:"over_provisioned,omitempty"`
+	SupportsUnmap   string      `json:"supports_unmap,omitempty"`
+}
+
+func (c *Client) PostLsMdisk() []*LsMdiskInst {
+	body, err := c.post("/rest/lsmdisk", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*LsMdiskInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+type MdiskStatus string
+
+const (
+	MdiskStatusEnumOnline        MdiskStatus = "online"
+	MdiskStatusEnumDegraded      MdiskStatus = "degraded"
+	MdiskStatusEnumDegradedPaths MdiskStatus = "degraded_paths"
+	MdiskStatusEnumDegradedPorts MdiskStatus = "degraded_ports"
+	MdiskStatusEnumOffline       MdiskStatus = "offline"
+	MdiskStatusEnumExcluded      MdiskStatus = "excluded"
+)
+
+func (_ms MdiskStatus) Float64() float64 {
+	switch _ms {
+	case MdiskStatusEnumOnline:
+		return 0.0
+	case MdiskStatusEnumDegraded, MdiskStatusEnumDegradedPaths, MdiskStatusEnumDegradedPorts:
+		return 1.0
+	case MdiskStatusEnumOffline:
+		return 2.0
+	case MdiskStatusEnumExcluded:
+		return 3.0
+	default:
+		return -1.0
+	}
+}
@@ -0,0 +1,107 @@
+package spectrum
+
+import "encoding/json"
+
+type LsMdiskGrpInst struct {
+	Id                              string         `json:"id,omitempty"`
+	Name                            string         `json:"name,omitempty"`
+	Status                          MdiskGrpStatus `json:"status,omitempty"`
+	MdiskCount                      String         `json:"mdisk_count,omitempty"`
+	VdiskCount                      String         `json:"vdisk_count,omitempty"`
+	Capacity                        string         `json:"capacity,omitempty"`
+	ExtentSize                      string         `json:"extent_size,omitempty"`
+	FreeCapacity                    string         `json:"free_capacity,omitempty"`
+	VirtualCapacity                 string         `json:"virtual_capacity,omitempty"`
+	UsedCapacity                    string         `json:"used_capacity,omitempty"`
+	RealCapacity                    string         `json:"real_capacity,omitempty"`
+	Overallocation                  String         `json:"overallocation,omitempty"`
+	Warning                         String         `json:"warning,omitempty"`
+	EasyTier                        string         `json:"easy_tier,omitempty"`
+	EasyTierStatus                  EasyTierStatus `json:"easy_tier_status,omitempty"`
+	CompressionActive               YesNo          `json:"compression_active,omitempty"`
+	CompressionVirtualCapacity      string         `json:"compression_virtual_capacity,omitempty"`
+	CompressionCompressedCapacity   string         `json:"compression_compressed_capacity,omitempty"`
+	CompressionUncompressedCapacity string         `json:"compression_uncompressed_capacity,omitempty"`
+	ParentMdiskGrpId                string         `json:"parent_mdisk_grp_id,omitempty"`
+	ParentMdiskGrpName              string         `json:"parent_mdisk_grp_name,omitempty"`
+	ChildMdiskGrpCount              String         `json:"child_mdisk_grp_count,omitempty"`
+	ChildMdiskGrpCapacity           string         `json:"child_mdisk_grp_capacity,omitempty"`
+	Type                            string         `json:"type,omitempty"`
+	Encrypt                         string         `json:"encrypt,omitempty"`
+	OwnerType                       string         `json:"owner_type,omitempty"`
+	SiteId                          string         `json:"site_id,omitempty"`
+	SiteName                        string         `json:"site_name,omitempty"`
+	DataReduction                   YesNo          `json:"data_reduction,omitempty"`
+	UsedCapacityBeforeReduction     string         `json:"used_capacity_before_reduction,omitempty"`
+	UsedCapacityAfterReduction      string         `json:"used_capacity_after_reduction,omitempty"`
+	OverheadCapacity                string         `json:"overhead_capacity,omitempty"`
+	DeduplicationCapacitySaving     string         `json:"deduplication_capacity_saving,omitempty"`
+	ReclaimableCapacity             string         `json:"reclaimable_capacity,omitempty"`
+	ProvisioningPolicyId            string         `json:"provisioning_policy_id,omitempty"`
+	ProvisioningPolicyName          string         `json:"provisioning_policy_name,omitempty"`
+}
+
+func (c *Client) PostLsMdiskGrp() []*LsMdiskGrpInst {
+	body, err := c.post("/rest/lsmdiskgrp", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*LsMdiskGrpInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+type MdiskGrpStatus string
+
+const (
+	MdiskGrpStatusEnumOnline        MdiskGrpStatus = "online"
+	MdiskGrpStatusEnumDegraded      MdiskGrpStatus = "degraded"
+	MdiskGrpStatusEnumDegradedPaths MdiskGrpStatus = "degraded_paths"
+	MdiskGrpStatusEnumDegradedPorts MdiskGrpStatus = "degraded_ports"
+	MdiskGrpStatusEnumOffline       MdiskGrpStatus = "offline"
+)
+
+func (_ms MdiskGrpStatus) Float64() float64 {
+	switch _ms {
+	case MdiskGrpStatusEnumOnline:
+		return 0.0
+	case MdiskGrpStatusEnumDegraded, MdiskGrpStatusEnumDegradedPaths, MdiskGrpStatusEnumDegradedPorts:
+		return 1.0
+	case MdiskGrpStatusEnumOffline:
+		return 2.0
+	default:
+		return -1.0
+	}
+}
+
+type EasyTierStatus string
+
+const (
+	EasyTierStatusEnumInactive EasyTierStatus = "inactive"
+	EasyTierStatusEnumActive   EasyTierStatus = "active"
+	EasyTierStatusEnumMeasured EasyTierStatus = "measured"
+	EasyTierStatusEnumBalanced EasyTierStatus = "balanced"
+)
+
+func (_es EasyTierStatus) Float64() float64 {
+	switch _es {
+	case EasyTierStatusEnumInactive:
+		return 0.0
+	case EasyTierStatusEnumActive:
+		return 1.0
+	case EasyTierStatusEnumMeasured:
+		return 2.0
+	case EasyTierStatusEnumBalanced:
+		return 3.0
+	default:
+		return -1.0
+	}
+}
@@ -0,0 +1,42 @@
+package spectrum
+
+import "encoding/json"
+
+type LsNodeCanisterInst struct {
+	Id                    string          `json:"id,omitempty"`
+	Name                  string          `json:"name,omitempty"`
+	UPSSerialNumber       string          `json:"UPS_serial_number,omitempty"`
+	WWNN                  string          `json:"WWNN,omitempty"`
+	Status                ComponentStatus `json:"status,omitempty"`
+	IOGroupId             string          `json:"IO_group_id,omitempty"`
+	IOGroupName           string          `json:"IO_group_name,omitempty"`
+	ConfigNode            string          `json:"config_node,omitempty"`
+	UPSUniqueId           string          `json:"UPS_unique_id,omitempty"`
+	Hardware              string          `json:"hardware,omitempty"`
+	IscsiName             string          `json:"iscsi_name,omitempty"`
+	IscsiAlias            string          `json:"iscsi_alias,omitempty"`
+	PanelName             string          `json:"panel_name,omitempty"`
+	EnclosureId           string          `json:"enclosure_id,omitempty"`
+	CanisterId            string          `json:"canister_id,omitempty"`
+	EnclosureSerialNumber string          `json:"enclosure_serial_number,omitempty"`
+	SiteId                string          `json:"site_id,omitempty"`
+	SiteName              string          `json:"site_name,omitempty"`
+}
+
+func (c *Client) PostLsNodeCanister() []*LsNodeCanisterInst {
+	body, err := c.post("/rest/lsnodecanister", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*LsNodeCanisterInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
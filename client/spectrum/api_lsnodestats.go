@@ -0,0 +1,30 @@
+package spectrum
+
+import "encoding/json"
+
+type LsNodeStatsInst struct {
+	NodeId       string `json:"node_id"`
+	NodeName     string `json:"node_name"`
+	StatName     string `json:"stat_name"`
+	StatCurrent  string `json:"stat_current,omitempty"`
+	StatPeak     string `json:"stat_peak,omitempty"`
+	StatPeakTime string `json:"stat_peak_time,omitempty"`
+}
+
+func (c *Client) PostLsNodeStats() []*LsNodeStatsInst {
+	body, err := c.post("/rest/lsnodestats", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*LsNodeStatsInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
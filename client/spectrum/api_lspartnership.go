@@ -0,0 +1,71 @@
+package spectrum
+
+import "encoding/json"
+
+type LsPartnershipInst struct {
+	Id                 string            `json:"id,omitempty"`
+	Name               string            `json:"name,omitempty"`
+	Location           string            `json:"location,omitempty"`
+	Partnership        PartnershipStatus `json:"partnership,omitempty"`
+	Type               string            `json:"type,omitempty"`
+	ClusterIP          string            `json:"cluster_ip,omitempty"`
+	EventLogSequence   string            `json:"event_log_sequence,omitempty"`
+	LinkBandwidthMbits String            `json:"link_bandwidth_mbits,omitempty"`
+}
+
+func (c *Client) PostLsPartnership() []*LsPartnershipInst {
+	body, err := c.post("/rest/lspartnership", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*LsPartnershipInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+type PartnershipStatus string
+
+const (
+	PartnershipStatusEnumFullyConfigured                 PartnershipStatus = "fully_configured"
+	PartnershipStatusEnumPartiallyConfiguredLocal        PartnershipStatus = "partially_configured_local"
+	PartnershipStatusEnumPartiallyConfiguredLocalStopped PartnershipStatus = "partially_configured_local_stopped"
+	PartnershipStatusEnumFullyConfiguredStopped          PartnershipStatus = "fully_configured_stopped"
+	PartnershipStatusEnumFullyConfiguredRemoteStopped    PartnershipStatus = "fully_configured_remote_stopped"
+	PartnershipStatusEnumFullyConfiguredLocalExcluded    PartnershipStatus = "fully_configured_local_excluded"
+	PartnershipStatusEnumFullyConfiguredRemoteExcluded   PartnershipStatus = "fully_configured_remote_excluded"
+	PartnershipStatusEnumFullyConfiguredExceeded         PartnershipStatus = "fully_configured_exceeded"
+	PartnershipStatusEnumNotPresent                      PartnershipStatus = "not_present"
+)
+
+func (_ps PartnershipStatus) Float64() float64 {
+	switch _ps {
+	case PartnershipStatusEnumFullyConfigured:
+		return 0.0
+	case PartnershipStatusEnumPartiallyConfiguredLocal:
+		return 1.0
+	case PartnershipStatusEnumPartiallyConfiguredLocalStopped:
+		return 2.0
+	case PartnershipStatusEnumFullyConfiguredStopped:
+		return 3.0
+	case PartnershipStatusEnumFullyConfiguredRemoteStopped:
+		return 4.0
+	case PartnershipStatusEnumFullyConfiguredLocalExcluded:
+		return 5.0
+	case PartnershipStatusEnumFullyConfiguredRemoteExcluded:
+		return 6.0
+	case PartnershipStatusEnumFullyConfiguredExceeded:
+		return 7.0
+	case PartnershipStatusEnumNotPresent:
+		return 8.0
+	default:
+		return -1.0
+	}
+}
@@ -0,0 +1,36 @@
+package spectrum
+
+import "encoding/json"
+
+type LsPortEthernetInst struct {
+	Id              string     `json:"id,omitempty"`
+	PortId          string     `json:"port_id,omitempty"`
+	NodeId          string     `json:"node_id,omitempty"`
+	NodeName        string     `json:"node_name,omitempty"`
+	MAC             string     `json:"MAC,omitempty"`
+	Duplex          string     `json:"duplex,omitempty"`
+	Speed           PortSpeed  `json:"speed,omitempty"`
+	LinkState       PortStatus `json:"link_state,omitempty"`
+	AdapterLocation string     `json:"adapter_location,omitempty"`
+	AdapterPortId   string     `json:"adapter_port_id,omitempty"`
+	IsRdmaCapable   string     `json:"is_rdma_capable,omitempty"`
+	Mtu             String     `json:"mtu,omitempty"`
+}
+
+func (c *Client) PostLsPortEthernet() []*LsPortEthernetInst {
+	body, err := c.post("/rest/lsportethernet", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*LsPortEthernetInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
@@ -0,0 +1,90 @@
+package spectrum
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+type LsPortFcInst struct {
+	Id              string     `json:"id,omitempty"`
+	FcIoPortId      string     `json:"fc_io_port_id,omitempty"`
+	PortId          string     `json:"port_id,omitempty"`
+	Type            string     `json:"type,omitempty"`
+	PortSpeed       PortSpeed  `json:"port_speed,omitempty"`
+	NodeId          string     `json:"node_id,omitempty"`
+	NodeName        string     `json:"node_name,omitempty"`
+	WWPN            string     `json:"WWPN,omitempty"`
+	NportId         string     `json:"nportid,omitempty"`
+	Status          PortStatus `json:"status,omitempty"`
+	Attachment      string     `json:"attachment,omitempty"`
+	ClusterUse      string     `json:"cluster_use,omitempty"`
+	AdapterLocation string     `json:"adapter_location,omitempty"`
+	AdapterPortId   string     `json:"adapter_port_id,omitempty"`
+}
+
+func (c *Client) PostLsPortFc() []*LsPortFcInst {
+	body, err := c.post("/rest/lsportfc", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*LsPortFcInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+type PortStatus string
+
+const (
+	PortStatusEnumActive               PortStatus = "active"
+	PortStatusEnumInactiveUnconfigured PortStatus = "inactive_unconfigured"
+	PortStatusEnumInactiveConfigured   PortStatus = "inactive_configured"
+	PortStatusEnumInactive             PortStatus = "inactive"
+)
+
+func (_ps PortStatus) Float64() float64 {
+	switch _ps {
+	case PortStatusEnumActive:
+		return 0.0
+	case PortStatusEnumInactiveUnconfigured:
+		return 1.0
+	case PortStatusEnumInactiveConfigured:
+		return 2.0
+	case PortStatusEnumInactive:
+		return 3.0
+	default:
+		return -1.0
+	}
+}
+
+// PortSpeed
+// ex) 16Gb, 10Gb/s, 100Mb/s, N/A
+type PortSpeed string
+
+// Float64
+// Gb/s 단위로 변환합니다. 알 수 없는 값은 -1 을 리턴합니다.
+func (_ps PortSpeed) Float64() float64 {
+	s := strings.TrimSuffix(strings.ToLower(string(_ps)), "/s")
+	var unit float64
+	switch {
+	case strings.HasSuffix(s, "gb"):
+		unit = 1
+	case strings.HasSuffix(s, "mb"):
+		unit = 0.001
+	default:
+		return -1
+	}
+	f, err := strconv.ParseFloat(s[:len(s)-2], 64)
+	if err != nil {
+		return -1
+	}
+	return f * unit
+}
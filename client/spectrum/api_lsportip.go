@@ -0,0 +1,45 @@
+package spectrum
+
+import "encoding/json"
+
+type LsPortIpInst struct {
+	Id               string     `json:"id,omitempty"`
+	NodeId           string     `json:"node_id,omitempty"`
+	NodeName         string     `json:"node_name,omitempty"`
+	IPAddress        string     `json:"IP_address,omitempty"`
+	Mask             string     `json:"mask,omitempty"`
+	Gateway          string     `json:"gateway,omitempty"`
+	IPAddress6       string     `json:"IP_address_6,omitempty"`
+	MAC              string     `json:"MAC,omitempty"`
+	Duplex           string     `json:"duplex,omitempty"`
+	State            string     `json:"state,omitempty"`
+	Speed            PortSpeed  `json:"speed,omitempty"`
+	Failover         YesNo      `json:"failover,omitempty"`
+	LinkState        PortStatus `json:"link_state,omitempty"`
+	Host             YesNo      `json:"host,omitempty"`
+	RemoteCopy       string     `json:"remote_copy,omitempty"`
+	RemoteCopyStatus string     `json:"remote_copy_status,omitempty"`
+	Vlan             string     `json:"vlan,omitempty"`
+	AdapterLocation  string     `json:"adapter_location,omitempty"`
+	AdapterPortId    string     `json:"adapter_port_id,omitempty"`
+	Storage          YesNo      `json:"storage,omitempty"`
+	Mtu              String     `json:"mtu,omitempty"`
+}
+
+func (c *Client) PostLsPortIp() []*LsPortIpInst {
+	body, err := c.post("/rest/lsportip", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*LsPortIpInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
@@ -0,0 +1,35 @@
+package spectrum
+
+import "encoding/json"
+
+type LsQuorumInst struct {
+	QuorumIndex    string          `json:"quorum_index,omitempty"`
+	Status         ComponentStatus `json:"status,omitempty"`
+	Id             string          `json:"id,omitempty"`
+	Name           string          `json:"name,omitempty"`
+	ControllerId   string          `json:"controller_id,omitempty"`
+	ControllerName string          `json:"controller_name,omitempty"`
+	Active         YesNo           `json:"active,omitempty"`
+	ObjectType     string          `json:"object_type,omitempty"`
+	Override       string          `json:"override,omitempty"`
+	SiteId         string          `json:"site_id,omitempty"`
+	SiteName       string          `json:"site_name,omitempty"`
+}
+
+func (c *Client) PostLsQuorum() []*LsQuorumInst {
+	body, err := c.post("/rest/lsquorum", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*LsQuorumInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
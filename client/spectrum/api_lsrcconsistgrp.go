@@ -0,0 +1,36 @@
+package spectrum
+
+import "encoding/json"
+
+type LsRcConsistGrpInst struct {
+	Id                string     `json:"id,omitempty"`
+	Name              string     `json:"name,omitempty"`
+	MasterClusterId   string     `json:"master_cluster_id,omitempty"`
+	MasterClusterName string     `json:"master_cluster_name,omitempty"`
+	AuxClusterId      string     `json:"aux_cluster_id,omitempty"`
+	AuxClusterName    string     `json:"aux_cluster_name,omitempty"`
+	Primary           string     `json:"primary,omitempty"`
+	State             RcState    `json:"state,omitempty"`
+	RelationshipCount String     `json:"relationship_count,omitempty"`
+	CopyType          string     `json:"copy_type,omitempty"`
+	CyclingMode       string     `json:"cycling_mode,omitempty"`
+	FreezeTime        FreezeTime `json:"freeze_time,omitempty"`
+}
+
+func (c *Client) PostLsRcConsistGrp() []*LsRcConsistGrpInst {
+	body, err := c.post("/rest/lsrcconsistgrp", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*LsRcConsistGrpInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
@@ -0,0 +1,96 @@
+package spectrum
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type LsRcRelationshipInst struct {
+	Id                   string     `json:"id,omitempty"`
+	Name                 string     `json:"name,omitempty"`
+	MasterClusterId      string     `json:"master_cluster_id,omitempty"`
+	MasterClusterName    string     `json:"master_cluster_name,omitempty"`
+	MasterVdiskId        string     `json:"master_vdisk_id,omitempty"`
+	MasterVdiskName      string     `json:"master_vdisk_name,omitempty"`
+	AuxClusterId         string     `json:"aux_cluster_id,omitempty"`
+	AuxClusterName       string     `json:"aux_cluster_name,omitempty"`
+	AuxVdiskId           string     `json:"aux_vdisk_id,omitempty"`
+	AuxVdiskName         string     `json:"aux_vdisk_name,omitempty"`
+	Primary              string     `json:"primary,omitempty"`
+	ConsistencyGroupId   string     `json:"consistency_group_id,omitempty"`
+	ConsistencyGroupName string     `json:"consistency_group_name,omitempty"`
+	State                RcState    `json:"state,omitempty"`
+	BgCopyPriority       string     `json:"bg_copy_priority,omitempty"`
+	Progress             String     `json:"progress,omitempty"`
+	CopyType             string     `json:"copy_type,omitempty"`
+	CyclingMode          string     `json:"cycling_mode,omitempty"`
+	FreezeTime           FreezeTime `json:"freeze_time,omitempty"`
+}
+
+func (c *Client) PostLsRcRelationship() []*LsRcRelationshipInst {
+	body, err := c.post("/rest/lsrcrelationship", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*LsRcRelationshipInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+type RcState string
+
+const (
+	RcStateEnumConsistentSynchronized   RcState = "consistent_synchronized"
+	RcStateEnumConsistentCopying        RcState = "consistent_copying"
+	RcStateEnumInconsistentCopying      RcState = "inconsistent_copying"
+	RcStateEnumConsistentStopped        RcState = "consistent_stopped"
+	RcStateEnumInconsistentStopped      RcState = "inconsistent_stopped"
+	RcStateEnumIdling                   RcState = "idling"
+	RcStateEnumIdlingDisconnected       RcState = "idling_disconnected"
+	RcStateEnumConsistentDisconnected   RcState = "consistent_disconnected"
+	RcStateEnumInconsistentDisconnected RcState = "inconsistent_disconnected"
+	RcStateEnumEmpty                    RcState = "empty"
+)
+
+func (_rs RcState) Float64() float64 {
+	switch _rs {
+	case RcStateEnumConsistentSynchronized:
+		return 0.0
+	case RcStateEnumConsistentCopying:
+		return 1.0
+	case RcStateEnumInconsistentCopying:
+		return 2.0
+	case RcStateEnumConsistentStopped:
+		return 3.0
+	case RcStateEnumInconsistentStopped:
+		return 4.0
+	case RcStateEnumIdling:
+		return 5.0
+	case RcStateEnumIdlingDisconnected:
+		return 6.0
+	case RcStateEnumConsistentDisconnected:
+		return 7.0
+	case RcStateEnumInconsistentDisconnected:
+		return 8.0
+	case RcStateEnumEmpty:
+		return 9.0
+	default:
+		return -1.0
+	}
+}
+
+// FreezeTime
+// Global Mirror with Change Volumes 의 마지막 일관성 시점 (YYYY/MM/DD/HH/MM, 시스템 시간대)
+type FreezeTime string
+
+func (_ft FreezeTime) Time(loc *time.Location) (time.Time, error) {
+	return time.ParseInLocation("2006/01/02/15/04", string(_ft), loc)
+}
@@ -0,0 +1,45 @@
+package spectrum
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type LsSnapshotInst struct {
+	SnapshotId      string `json:"snapshot_id,omitempty"`
+	SnapshotName    string `json:"snapshot_name,omitempty"`
+	VolumeGroupId   string `json:"volume_group_id,omitempty"`
+	VolumeGroupName string `json:"volume_group_name,omitempty"`
+	State           string `json:"state,omitempty"`
+	ParentUID       string `json:"parent_uid,omitempty"`
+	TimeCreated     String `json:"time_created,omitempty"`
+	ExpirationTime  String `json:"expiration_time,omitempty"`
+	Protected       YesNo  `json:"protected,omitempty"`
+	Safeguarded     YesNo  `json:"safeguarded,omitempty"`
+	OwnerId         string `json:"owner_id,omitempty"`
+	OwnerName       string `json:"owner_name,omitempty"`
+}
+
+func (c *Client) PostLsSnapshot() []*LsSnapshotInst {
+	body, err := c.post("/rest/lssnapshot", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*LsSnapshotInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+// Created
+// time_created (YYMMDDHHMMSS) 를 시스템 시간대(loc, Client.Location 참고)로 변환합니다.
+func (_s *LsSnapshotInst) Created(loc *time.Location) (time.Time, error) {
+	return time.ParseInLocation("060102150405", string(_s.TimeCreated), loc)
+}
@@ -1,6 +1,10 @@
 package spectrum
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
 
 type LsSystemInst struct {
 	Id                            string `json:"id,omitempty"`
@@ -123,3 +127,48 @@ func (c *Client) PostLsSystem() *LsSystemInst {
 
 	return &data
 }
+
+// Location
+// 시스템에 설정된 time_zone (ex. "522 UTC", "314 Asia/Seoul") 을 반환합니다.
+// CLI 의 시간 값은 시스템 시간대 기준이므로, agent 가 실행되는 호스트의 시간대와 무관하게 변환하기 위해 사용합니다.
+// 조회에 실패하면 Time2Float64 와 같이 UTC 를 반환합니다.
+func (c *Client) Location() *time.Location {
+	if c.location != nil {
+		return c.location
+	}
+	data := c.PostLsSystem()
+	if data == nil {
+		return time.UTC
+	}
+	fields := strings.Fields(data.TimeZone)
+	if len(fields) == 0 {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(fields[len(fields)-1])
+	if err != nil {
+		return time.UTC
+	}
+	c.location = loc
+	return loc
+}
+
+type TopologyStatus string
+
+const (
+	TopologyStatusEnumDualSite       TopologyStatus = "dual_site"
+	TopologyStatusEnumRecoveredSite1 TopologyStatus = "recovered_site_1"
+	TopologyStatusEnumRecoveredSite2 TopologyStatus = "recovered_site_2"
+)
+
+func (_ts TopologyStatus) Float64() float64 {
+	switch _ts {
+	case TopologyStatusEnumDualSite:
+		return 0.0
+	case TopologyStatusEnumRecoveredSite1:
+		return 1.0
+	case TopologyStatusEnumRecoveredSite2:
+		return 2.0
+	default:
+		return -1.0
+	}
+}
@@ -0,0 +1,40 @@
+package spectrum
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type LsSystemCertInst struct {
+	Type         string `json:"type,omitempty"`
+	Subject      string `json:"subject,omitempty"`
+	Issuer       string `json:"issuer,omitempty"`
+	SerialNumber string `json:"serial_number,omitempty"`
+	KeyType      string `json:"key_type,omitempty"`
+	NotBefore    string `json:"not_before,omitempty"`
+	NotAfter     string `json:"not_after,omitempty"`
+}
+
+func (c *Client) PostLsSystemCert() *LsSystemCertInst {
+	body, err := c.post("/rest/lssystemcert", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data LsSystemCertInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return &data
+}
+
+// Expiry
+// not_after 는 openssl 형식(ex. Feb 11 14:43:45 2015 GMT)으로 출력됩니다.
+func (_c *LsSystemCertInst) Expiry() (time.Time, error) {
+	return time.Parse("Jan _2 15:04:05 2006 MST", _c.NotAfter)
+}
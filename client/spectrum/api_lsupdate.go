@@ -0,0 +1,74 @@
+package spectrum
+
+import "encoding/json"
+
+type LsUpdateInst struct {
+	Status                  UpdateStatus `json:"status,omitempty"`
+	EventSequenceNumber     string       `json:"event_sequence_number,omitempty"`
+	Progress                String       `json:"progress,omitempty"`
+	EstimatedCompletionTime String       `json:"estimated_completion_time,omitempty"`
+	SuggestedAction         string       `json:"suggested_action,omitempty"`
+	SystemNewCodeLevel      string       `json:"system_new_code_level,omitempty"`
+	SystemForced            YesNo        `json:"system_forced,omitempty"`
+	SystemNextNodeStatus    string       `json:"system_next_node_status,omitempty"`
+	SystemNextNodeTime      String       `json:"system_next_node_time,omitempty"`
+	SystemNextNodeId        string       `json:"system_next_node_id,omitempty"`
+	SystemNextNodeName      string       `json:"system_next_node_name,omitempty"`
+}
+
+func (c *Client) PostLsUpdate() *LsUpdateInst {
+	body, err := c.post("/rest/lsupdate", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data LsUpdateInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return &data
+}
+
+type UpdateStatus string
+
+const (
+	UpdateStatusEnumSuccess                  UpdateStatus = "success"
+	UpdateStatusEnumSystemPreparing          UpdateStatus = "system_preparing"
+	UpdateStatusEnumSystemPrepared           UpdateStatus = "system_prepared"
+	UpdateStatusEnumSystemUpdating           UpdateStatus = "system_updating"
+	UpdateStatusEnumSystemCompletionRequired UpdateStatus = "system_completion_required"
+	UpdateStatusEnumSystemPaused             UpdateStatus = "system_paused"
+	UpdateStatusEnumSystemAborting           UpdateStatus = "system_aborting"
+	UpdateStatusEnumStalled                  UpdateStatus = "stalled"
+	UpdateStatusEnumSystemPrepareFailed      UpdateStatus = "system_prepare_failed"
+)
+
+func (_us UpdateStatus) Float64() float64 {
+	switch _us {
+	case UpdateStatusEnumSuccess:
+		return 0.0
+	case UpdateStatusEnumSystemPreparing:
+		return 1.0
+	case UpdateStatusEnumSystemPrepared:
+		return 2.0
+	case UpdateStatusEnumSystemUpdating:
+		return 3.0
+	case UpdateStatusEnumSystemCompletionRequired:
+		return 4.0
+	case UpdateStatusEnumSystemPaused:
+		return 5.0
+	case UpdateStatusEnumSystemAborting:
+		return 6.0
+	case UpdateStatusEnumStalled:
+		return 7.0
+	case UpdateStatusEnumSystemPrepareFailed:
+		return 8.0
+	default:
+		return -1.0
+	}
+}
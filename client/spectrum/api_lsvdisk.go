@@ -0,0 +1,75 @@
+package spectrum
+
+import "encoding/json"
+
+type LsVdiskInst struct {
+	Id                  string      `json:"id,omitempty"`
+	Name                string      `json:"name,omitempty"`
+	IOGroupId           string      `json:"IO_group_id,omitempty"`
+	IOGroupName         string      `json:"IO_group_name,omitempty"`
+	Status              VdiskStatus `json:"status,omitempty"`
+	MdiskGrpId          string      `json:"mdisk_grp_id,omitempty"`
+	MdiskGrpName        string      `json:"mdisk_grp_name,omitempty"`
+	Capacity            string      `json:"capacity,omitempty"`
+	Type                string      `json:"type,omitempty"`
+	FCId                string      `json:"FC_id,omitempty"`
+	FCName              string      `json:"FC_name,omitempty"`
+	RCId                string      `json:"RC_id,omitempty"`
+	RCName              string      `json:"RC_name,omitempty"`
+	VdiskUID            string      `json:"vdisk_UID,omitempty"`
+	FcMapCount          String      `json:"fc_map_count,omitempty"`
+	CopyCount           String      `json:"copy_count,omitempty"`
+	FastWriteState      string      `json:"fast_write_state,omitempty"`
+	SeCopyCount         String      `json:"se_copy_count,omitempty"`
+	RCChange            string      `json:"RC_change,omitempty"`
+	CompressedCopyCount String      `json:"compressed_copy_count,omitempty"`
+	ParentMdiskGrpId    string      `json:"parent_mdisk_grp_id,omitempty"`
+	ParentMdiskGrpName  string      `json:"parent_mdisk_grp_name,omitempty"`
+	OwnerId             string      `json:"owner_id,omitempty"`
+	OwnerName           string      `json:"owner_name,omitempty"`
+	Formatting          string      `json:"formatting,omitempty"`
+	Encrypt             string      `json:"encrypt,omitempty"`
+	VolumeId            string      `json:"volume_id,omitempty"`
+	VolumeName          string      `json:"volume_name,omitempty"`
+	Function            string      `json:"function,omitempty"`
+	Protocol            string      `json:"protocol,omitempty"`
+}
+
+func (c *Client) PostLsVdisk() []*LsVdiskInst {
+	body, err := c.post("/rest/lsvdisk", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*LsVdiskInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+type VdiskStatus string
+
+const (
+	VdiskStatusEnumOnline   VdiskStatus = "online"
+	VdiskStatusEnumDegraded VdiskStatus = "degraded"
+	VdiskStatusEnumOffline  VdiskStatus = "offline"
+)
+
+func (_vs VdiskStatus) Float64() float64 {
+	switch _vs {
+	case VdiskStatusEnumOnline:
+		return 0.0
+	case VdiskStatusEnumDegraded:
+		return 1.0
+	case VdiskStatusEnumOffline:
+		return 2.0
+	default:
+		return -1.0
+	}
+}
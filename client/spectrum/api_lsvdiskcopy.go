@@ -0,0 +1,96 @@
+package spectrum
+
+import "encoding/json"
+
+type LsVdiskCopyInst struct {
+	VdiskId            string      `json:"vdisk_id,omitempty"`
+	VdiskName          string      `json:"vdisk_name,omitempty"`
+	CopyId             string      `json:"copy_id,omitempty"`
+	Status             VdiskStatus `json:"status,omitempty"`
+	Sync               YesNo       `json:"sync,omitempty"`
+	Primary            YesNo       `json:"primary,omitempty"`
+	MdiskGrpId         string      `json:"mdisk_grp_id,omitempty"`
+	MdiskGrpName       string      `json:"mdisk_grp_name,omitempty"`
+	Capacity           string      `json:"capacity,omitempty"`
+	Type               string      `json:"type,omitempty"`
+	SeCopy             YesNo       `json:"se_copy,omitempty"`
+	EasyTier           string      `json:"easy_tier,omitempty"`
+	EasyTierStatus     string      `json:"easy_tier_status,omitempty"`
+	CompressedCopy     YesNo       `json:"compressed_copy,omitempty"`
+	ParentMdiskGrpId   string      `json:"parent_mdisk_grp_id,omitempty"`
+	ParentMdiskGrpName string      `json:"parent_mdisk_grp_name,omitempty"`
+	Encrypt            string      `json:"encrypt,omitempty"`
+	DeduplicatedCopy   YesNo       `json:"deduplicated_copy,omitempty"`
+	Safeguarded        YesNo       `json:"safeguarded,omitempty"`
+}
+
+// LsSeVdiskCopyInst
+// lssevdiskcopy 는 thin/compressed copy 에 대해서만 used/real capacity 를 보여줍니다.
+type LsSeVdiskCopyInst struct {
+	VdiskId                  string `json:"vdisk_id,omitempty"`
+	VdiskName                string `json:"vdisk_name,omitempty"`
+	CopyId                   string `json:"copy_id,omitempty"`
+	MdiskGrpId               string `json:"mdisk_grp_id,omitempty"`
+	MdiskGrpName             string `json:"mdisk_grp_name,omitempty"`
+	Capacity                 string `json:"capacity,omitempty"`
+	UsedCapacity             string `json:"used_capacity,omitempty"`
+	RealCapacity             string `json:"real_capacity,omitempty"`
+	FreeCapacity             string `json:"free_capacity,omitempty"`
+	Overallocation           String `json:"overallocation,omitempty"`
+	Autoexpand               string `json:"autoexpand,omitempty"`
+	Warning                  String `json:"warning,omitempty"`
+	Grainsize                string `json:"grainsize,omitempty"`
+	SeCopy                   YesNo  `json:"se_copy,omitempty"`
+	CompressedCopy           YesNo  `json:"compressed_copy,omitempty"`
+	UncompressedUsedCapacity string `json:"uncompressed_used_capacity,omitempty"`
+	DeduplicatedCopy         YesNo  `json:"deduplicated_copy,omitempty"`
+}
+
+func (c *Client) PostLsVdiskCopy() []*LsVdiskCopyInst {
+	body, err := c.post("/rest/lsvdiskcopy", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*LsVdiskCopyInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+func (c *Client) PostLsSeVdiskCopy() []*LsSeVdiskCopyInst {
+	body, err := c.post("/rest/lssevdiskcopy", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*LsSeVdiskCopyInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+type YesNo string
+
+func (_yn YesNo) Float64() float64 {
+	switch _yn {
+	case "yes":
+		return 1.0
+	case "no":
+		return 0.0
+	default:
+		return -1.0
+	}
+}
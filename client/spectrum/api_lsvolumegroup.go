@@ -0,0 +1,44 @@
+package spectrum
+
+import "encoding/json"
+
+type LsVolumeGroupInst struct {
+	Id                         string `json:"id,omitempty"`
+	Name                       string `json:"name,omitempty"`
+	VolumeCount                String `json:"volume_count,omitempty"`
+	BackupStatus               string `json:"backup_status,omitempty"`
+	LastBackupTime             string `json:"last_backup_time,omitempty"`
+	OwnerId                    string `json:"owner_id,omitempty"`
+	OwnerName                  string `json:"owner_name,omitempty"`
+	SafeguardedPolicyId        string `json:"safeguarded_policy_id,omitempty"`
+	SafeguardedPolicyName      string `json:"safeguarded_policy_name,omitempty"`
+	SafeguardedPolicyStartTime string `json:"safeguarded_policy_start_time,omitempty"`
+	ReplicationPolicyId        string `json:"replication_policy_id,omitempty"`
+	ReplicationPolicyName      string `json:"replication_policy_name,omitempty"`
+	VolumeGroupType            string `json:"volume_group_type,omitempty"`
+	UID                        string `json:"uid,omitempty"`
+	SourceVolumeGroupId        string `json:"source_volume_group_id,omitempty"`
+	SourceVolumeGroupName      string `json:"source_volume_group_name,omitempty"`
+	SnapshotPolicyId           string `json:"snapshot_policy_id,omitempty"`
+	SnapshotPolicyName         string `json:"snapshot_policy_name,omitempty"`
+	SnapshotPolicySuspended    YesNo  `json:"snapshot_policy_suspended,omitempty"`
+	SnapshotPolicySafeguarded  YesNo  `json:"snapshot_policy_safeguarded,omitempty"`
+}
+
+func (c *Client) PostLsVolumeGroup() []*LsVolumeGroupInst {
+	body, err := c.post("/rest/lsvolumegroup", nil)
+	if err != nil {
+		c.lastAuth = false
+		return nil
+	}
+	if body == nil {
+		return nil
+	}
+	var data []*LsVolumeGroupInst
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
@@ -18,6 +18,8 @@ type Client struct {
 	token      string
 	lastAuth   bool
 	lastAccess time.Time
+	// lssystem 의 time_zone (Location 참고)
+	location *time.Location
 
 	httpClient *http.Client
 }
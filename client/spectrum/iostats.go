@@ -0,0 +1,256 @@
+package spectrum
+
+import (
+	"encoding/xml"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const IOStatsPrefix = "/dumps/iostats"
+
+// IOStatsType
+// iostats dump 파일명의 접두어 (Nv: volume, Nm: mdisk, Nn: node, Nd: drive)
+type IOStatsType string
+
+const (
+	IOStatsTypeEnumVolume IOStatsType = "Nv"
+	IOStatsTypeEnumMdisk  IOStatsType = "Nm"
+	IOStatsTypeEnumNode   IOStatsType = "Nn"
+	IOStatsTypeEnumDrive  IOStatsType = "Nd"
+)
+
+var IOStatsTypes = []IOStatsType{
+	IOStatsTypeEnumVolume,
+	IOStatsTypeEnumMdisk,
+	IOStatsTypeEnumNode,
+	IOStatsTypeEnumDrive,
+}
+
+// ParseIOStatsFilename
+// 파일명의 시간은 시스템 시간대(loc, Client.Location 참고) 기준입니다.
+// ex) Nv_stats_78N16G4-1_230101_120000 => Nv, 78N16G4-1, 2023-01-01 12:00:00
+func ParseIOStatsFilename(filename string, loc *time.Location) (IOStatsType, string, time.Time, error) {
+	parts := strings.Split(filename, "_")
+	if len(parts) < 5 || parts[1] != "stats" {
+		return "", "", time.Time{}, errors.New("invalid iostats filename: " + filename)
+	}
+	n := len(parts)
+	t, err := time.ParseInLocation("060102150405", parts[n-2]+parts[n-1], loc)
+	if err != nil {
+		return "", "", time.Time{}, err
+	}
+	return IOStatsType(parts[0]), strings.Join(parts[2:n-2], "_"), t, nil
+}
+
+type IOStats struct {
+	XMLName      xml.Name       `xml:"diskStatsColl"`
+	Scope        string         `xml:"scope,attr"`
+	Id           string         `xml:"id,attr"`
+	Cluster      string         `xml:"cluster,attr"`
+	NodeId       string         `xml:"node_id,attr"`
+	Timestamp    string         `xml:"timestamp,attr"`
+	TimestampUTC string         `xml:"timestampUTC,attr"`
+	SizeUnits    string         `xml:"sizeUnits,attr"`
+	TimeUnits    string         `xml:"timeUnits,attr"`
+	Contains     string         `xml:"contains,attr"`
+	Vdisks       []*IOStatsDisk `xml:"vdsk"`
+	Mdisks       []*IOStatsDisk `xml:"mdsk"`
+	Cpu          *IOStatsCpu    `xml:"cpu"`
+	CpuCores     []*IOStatsCpu  `xml:"cpu_core"`
+	Ports        []*IOStatsPort `xml:"port"`
+	Time         time.Time      `xml:"-"`
+	BlockSize    float64        `xml:"-"`
+}
+
+// IOStatsDisk
+// 모든 값은 누적 카운터입니다.
+// ro/wo: IO 수, rb/wb: 블록 수(sizeUnits), rl/wl: volume 누적 응답시간(ms), re/we: mdisk/drive 누적 응답시간(ms)
+type IOStatsDisk struct {
+	Id  string  `xml:"id,attr"`
+	Idx string  `xml:"idx,attr"`
+	Ro  float64 `xml:"ro,attr"`
+	Wo  float64 `xml:"wo,attr"`
+	Rb  float64 `xml:"rb,attr"`
+	Wb  float64 `xml:"wb,attr"`
+	Rl  float64 `xml:"rl,attr"`
+	Wl  float64 `xml:"wl,attr"`
+	Re  float64 `xml:"re,attr"`
+	We  float64 `xml:"we,attr"`
+}
+
+type IOStatsCpu struct {
+	Id   string  `xml:"id,attr"`
+	Busy float64 `xml:"busy,attr"`
+	Comp float64 `xml:"comp,attr"`
+}
+
+// IOStatsPort
+// hbt/hbr: host 송수신 bytes, het/her: host 송수신 exchange 수
+type IOStatsPort struct {
+	Id   string  `xml:"id,attr"`
+	Type string  `xml:"type,attr"`
+	Wwpn string  `xml:"wwpn,attr"`
+	Iqn  string  `xml:"iqn,attr"`
+	Hbt  float64 `xml:"hbt,attr"`
+	Hbr  float64 `xml:"hbr,attr"`
+	Het  float64 `xml:"het,attr"`
+	Her  float64 `xml:"her,attr"`
+}
+
+// ParseIOStats
+// timestampUTC 가 없는 경우 timestamp 를 시스템 시간대(loc)로 변환합니다.
+func ParseIOStats(body []byte, loc *time.Location) (*IOStats, error) {
+	var data IOStats
+	err := xml.Unmarshal(body, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	if data.TimestampUTC != "" {
+		data.Time, err = time.Parse("2006-01-02 15:04:05", data.TimestampUTC)
+	} else {
+		data.Time, err = time.ParseInLocation("2006-01-02 15:04:05", data.Timestamp, loc)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	data.BlockSize = 512
+	if strings.HasSuffix(data.SizeUnits, "B") {
+		size, err := strconv.ParseFloat(strings.TrimSuffix(data.SizeUnits, "B"), 64)
+		if err == nil {
+			data.BlockSize = size
+		}
+	}
+
+	return &data, nil
+}
+
+type IOStatsRate struct {
+	NodeName string
+	Time     time.Time
+	Interval time.Duration // 두 샘플의 간격
+	Disks    []*IOStatsDiskRate
+	Ports    []*IOStatsPortRate
+	CpuBusy  float64
+}
+
+// IOStatsDiskRate
+// Throughput 단위는 bytes/s, Latency 단위는 ms 입니다.
+type IOStatsDiskRate struct {
+	Id              string
+	ReadIOPS        float64
+	WriteIOPS       float64
+	ReadThroughput  float64
+	WriteThroughput float64
+	ReadLatency     float64
+	WriteLatency    float64
+}
+
+type IOStatsPortRate struct {
+	Id           string
+	Type         string
+	Wwpn         string
+	TxThroughput float64
+	RxThroughput float64
+	TxIOPS       float64
+	RxIOPS       float64
+}
+
+// CalcIOStatsRate
+// 동일 노드의 연속된 두 샘플로부터 초당 값을 계산합니다.
+// 카운터가 초기화된 항목(노드 재시작 등)은 제외합니다.
+func CalcIOStatsRate(prev *IOStats, cur *IOStats) (*IOStatsRate, error) {
+	if prev == nil || cur == nil {
+		return nil, errors.New("two samples are required")
+	}
+	elapsed := cur.Time.Sub(prev.Time).Seconds()
+	if elapsed <= 0 {
+		return nil, errors.New("samples are not in order")
+	}
+
+	rate := &IOStatsRate{
+		NodeName: cur.Id,
+		Time:     cur.Time,
+		Interval: cur.Time.Sub(prev.Time),
+		CpuBusy:  -1,
+	}
+
+	// Disks
+	prevDisks := make(map[string]*IOStatsDisk)
+	for _, v := range append(prev.Vdisks, prev.Mdisks...) {
+		prevDisks[v.Id] = v
+	}
+	for _, v := range append(cur.Vdisks, cur.Mdisks...) {
+		p, ok := prevDisks[v.Id]
+		if !ok {
+			continue
+		}
+		ro, wo := v.Ro-p.Ro, v.Wo-p.Wo
+		rb, wb := v.Rb-p.Rb, v.Wb-p.Wb
+		rl, wl := (v.Rl+v.Re)-(p.Rl+p.Re), (v.Wl+v.We)-(p.Wl+p.We)
+		if ro < 0 || wo < 0 || rb < 0 || wb < 0 || rl < 0 || wl < 0 {
+			continue
+		}
+		diskRate := &IOStatsDiskRate{
+			Id:              v.Id,
+			ReadIOPS:        ro / elapsed,
+			WriteIOPS:       wo / elapsed,
+			ReadThroughput:  rb * cur.BlockSize / elapsed,
+			WriteThroughput: wb * cur.BlockSize / elapsed,
+		}
+		if ro > 0 {
+			diskRate.ReadLatency = rl / ro
+		}
+		if wo > 0 {
+			diskRate.WriteLatency = wl / wo
+		}
+		rate.Disks = append(rate.Disks, diskRate)
+	}
+
+	// Ports
+	prevPorts := make(map[string]*IOStatsPort)
+	for _, v := range prev.Ports {
+		prevPorts[v.Type+v.Id] = v
+	}
+	for _, v := range cur.Ports {
+		p, ok := prevPorts[v.Type+v.Id]
+		if !ok {
+			continue
+		}
+		hbt, hbr, het, her := v.Hbt-p.Hbt, v.Hbr-p.Hbr, v.Het-p.Het, v.Her-p.Her
+		if hbt < 0 || hbr < 0 || het < 0 || her < 0 {
+			continue
+		}
+		wwpn := v.Wwpn
+		if wwpn == "" {
+			wwpn = v.Iqn
+		}
+		rate.Ports = append(rate.Ports, &IOStatsPortRate{
+			Id:           v.Id,
+			Type:         v.Type,
+			Wwpn:         wwpn,
+			TxThroughput: hbt / elapsed,
+			RxThroughput: hbr / elapsed,
+			TxIOPS:       het / elapsed,
+			RxIOPS:       her / elapsed,
+		})
+	}
+
+	// CPU (busy 는 누적 ms)
+	if len(cur.CpuCores) > 0 && len(cur.CpuCores) == len(prev.CpuCores) {
+		var busy float64
+		for i, v := range cur.CpuCores {
+			busy += v.Busy - prev.CpuCores[i].Busy
+		}
+		if busy >= 0 {
+			rate.CpuBusy = busy / (elapsed * 1000 * float64(len(cur.CpuCores))) * 100
+		}
+	} else if cur.Cpu != nil && prev.Cpu != nil && cur.Cpu.Busy >= prev.Cpu.Busy {
+		rate.CpuBusy = (cur.Cpu.Busy - prev.Cpu.Busy) / (elapsed * 1000) * 100
+	}
+
+	return rate, nil
+}
@@ -0,0 +1,31 @@
+package unisphere
+
+import "time"
+
+type AlertInstances = Instances[AlertContent]
+
+type AlertContent struct {
+	Id             string         `json:"id,omitempty"`
+	Timestamp      time.Time      `json:"timestamp,omitempty"`
+	Severity       SeverityEnum   `json:"severity,omitempty"`
+	Component      ResourceRef    `json:"component,omitempty"`
+	MessageId      string         `json:"messageId,omitempty"`
+	Message        string         `json:"message,omitempty"`
+	DescriptionId  string         `json:"descriptionId,omitempty"`
+	ResolutionId   string         `json:"resolutionId,omitempty"`
+	Resolution     string         `json:"resolution,omitempty"`
+	IsAcknowledged bool           `json:"isAcknowledged,omitempty"`
+	State          AlertStateEnum `json:"state,omitempty"`
+}
+
+type AlertStateEnum int
+
+const (
+	AlertStateActiveManual AlertStateEnum = iota
+	AlertStateActiveAuto
+	AlertStateInactive
+)
+
+func (c *UnisphereClient) GetAlertInstances(fields []string, filter *Filter) (*AlertInstances, error) {
+	return getInstances[AlertContent](c, "alert", fields, filter)
+}
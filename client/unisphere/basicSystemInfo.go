@@ -1,18 +1,6 @@
 package unisphere
 
-import (
-	"encoding/json"
-	"strings"
-	"time"
-)
-
-type BasicSystemInfoInstances struct {
-	Base    string    `json:"@base"`
-	Updated time.Time `json:"updated"`
-	Entries []struct {
-		Content BasicSystemInfoContent `json:"content"`
-	} `json:"entries"`
-}
+type BasicSystemInfoInstances = Instances[BasicSystemInfoContent]
 
 type BasicSystemInfoContent struct {
 	Id                  string `json:"id,omitempty"`
@@ -24,21 +12,6 @@ type BasicSystemInfoContent struct {
 	EarliestApiVersion  string `json:"earliestApiVersion,omitempty"`
 }
 
-func (c *UnisphereClient) GetBasicSystemInfo(fields []string) (*BasicSystemInfoInstances, error) {
-	path := "/api/types/basicSystemInfo/instances?compact=true"
-	if len(fields) != 0 {
-		path += "&fields=" + strings.Join(fields, ",")
-	}
-	body, err := c.get(path)
-	if err != nil {
-		return nil, err
-	}
-
-	var data BasicSystemInfoInstances
-	err = json.Unmarshal(body, &data)
-	if err != nil {
-		return nil, err
-	}
-
-	return &data, nil
+func (c *UnisphereClient) GetBasicSystemInfoInstances(fields []string, filter *Filter) (*BasicSystemInfoInstances, error) {
+	return getInstances[BasicSystemInfoContent](c, "basicSystemInfo", fields, filter)
 }
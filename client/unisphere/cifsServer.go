@@ -0,0 +1,19 @@
+package unisphere
+
+type CifsServerInstances = Instances[CifsServerContent]
+
+type CifsServerContent struct {
+	Id           string      `json:"id,omitempty"`
+	Health       Health      `json:"health,omitempty"`
+	Name         string      `json:"name,omitempty"`
+	Description  string      `json:"description,omitempty"`
+	NetbiosName  string      `json:"netbiosName,omitempty"`
+	Domain       string      `json:"domain,omitempty"`
+	Workgroup    string      `json:"workgroup,omitempty"`
+	IsStandalone bool        `json:"isStandalone,omitempty"`
+	NasServer    ResourceRef `json:"nasServer,omitempty"`
+}
+
+func (c *UnisphereClient) GetCifsServerInstances(fields []string, filter *Filter) (*CifsServerInstances, error) {
+	return getInstances[CifsServerContent](c, "cifsServer", fields, filter)
+}
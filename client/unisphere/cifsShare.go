@@ -0,0 +1,19 @@
+package unisphere
+
+type CifsShareInstances = Instances[CifsShareContent]
+
+type CifsShareContent struct {
+	Id          string      `json:"id,omitempty"`
+	Name        string      `json:"name,omitempty"`
+	Path        string      `json:"path,omitempty"`
+	ExportPaths []string    `json:"exportPaths,omitempty"`
+	Description string      `json:"description,omitempty"`
+	IsReadOnly  bool        `json:"isReadOnly,omitempty"`
+	Filesystem  ResourceRef `json:"filesystem,omitempty"`
+	Snap        ResourceRef `json:"snap,omitempty"`
+	CifsServer  ResourceRef `json:"cifsServer,omitempty"`
+}
+
+func (c *UnisphereClient) GetCifsShareInstances(fields []string, filter *Filter) (*CifsShareInstances, error) {
+	return getInstances[CifsShareContent](c, "cifsShare", fields, filter)
+}
@@ -3,14 +3,18 @@ package unisphere
 import (
 	"bytes"
 	"crypto/tls"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/cookiejar"
 	"time"
 )
 
+var ErrNotAuthenticated = errors.New("not authenticated, waiting for retry")
+
 type UnisphereClient struct {
 	endpoint   string
 	auth       string
@@ -20,10 +24,10 @@ type UnisphereClient struct {
 	hc         *http.Client
 }
 
-func NewClient(endpoint string, auth string, insecure bool) *UnisphereClient {
+func NewClient(endpoint string, us string, pw string, insecure bool) *UnisphereClient {
 	return &UnisphereClient{
 		endpoint: endpoint,
-		auth:     auth,
+		auth:     base64.StdEncoding.EncodeToString([]byte(us + ":" + pw)),
 		hc: &http.Client{
 			Transport: &http.Transport{
 				TLSClientConfig: &tls.Config{
@@ -55,8 +59,9 @@ func (c *UnisphereClient) get(path string) ([]byte, error) {
 	// 최근 로그인 실패했을 경우, 인증
 	if !c.lastAccess {
 		if time.Since(c.accessTime).Minutes() < 1 {
-			return nil, nil
+			return nil, ErrNotAuthenticated
 		}
+		c.accessTime = time.Now()
 		c.hc.Jar, _ = cookiejar.New(nil)
 		req.Header.Add("Authorization", "Basic "+c.auth)
 	}
@@ -83,9 +88,9 @@ func (c *UnisphereClient) get(path string) ([]byte, error) {
 		c.lastAccess = false
 		err = errors.New("Unauthorized: " + string(body))
 	case http.StatusUnprocessableEntity:
-		var data StatusUnProcessableEntityError
-		_ = json.Unmarshal(body, &data)
-		err = errors.New("StatusUnprocessableEntity(422): " + data.Error.Messages[0].EnUS)
+		err = unprocessableEntityError(body)
+	default:
+		err = fmt.Errorf("unexpected status code(%d): %s", resp.StatusCode, string(body))
 	}
 	if !success {
 		return nil, err
@@ -100,8 +105,9 @@ func (c *UnisphereClient) get(path string) ([]byte, error) {
 }
 
 func (c *UnisphereClient) post(path string, data []byte) ([]byte, error) {
+	// POST 요청에는 로그인 시 발급된 EMC-CSRF-TOKEN 이 필요하므로, GET 요청으로 먼저 인증
 	if !c.lastAccess {
-		return nil, nil
+		return nil, ErrNotAuthenticated
 	}
 	req, err := http.NewRequest("POST", c.endpoint+path, bytes.NewBuffer(data))
 	if err != nil {
@@ -110,13 +116,6 @@ func (c *UnisphereClient) post(path string, data []byte) ([]byte, error) {
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Add("EMC-CSRF-TOKEN", c.token)
 	req.Header.Add("X-EMC-REST-CLIENT", "true")
-	if !c.lastAccess {
-		if time.Since(c.accessTime).Minutes() < 1 {
-			return nil, nil
-		}
-		c.hc.Jar, _ = cookiejar.New(nil)
-		req.Header.Add("Authorization", "Basic "+c.auth)
-	}
 
 	resp, err := c.hc.Do(req)
 	if err != nil {
@@ -138,18 +137,22 @@ func (c *UnisphereClient) post(path string, data []byte) ([]byte, error) {
 		c.lastAccess = success
 		err = errors.New("Unauthorized: " + string(body))
 	case http.StatusUnprocessableEntity:
-		var respData StatusUnProcessableEntityError
-		_ = json.Unmarshal(body, &respData)
-		err = errors.New("StatusUnprocessableEntity(422): " + respData.Error.Messages[0].EnUS)
+		err = unprocessableEntityError(body)
+	default:
+		err = fmt.Errorf("unexpected status code(%d): %s", resp.StatusCode, string(body))
 	}
 	if !success {
 		return nil, err
 	}
 
-	if !c.lastAccess {
-		c.token = resp.Header.Get("EMC-CSRF-TOKEN")
-		c.lastAccess = true
-	}
-
 	return body, nil
 }
+
+func unprocessableEntityError(body []byte) error {
+	var data StatusUnProcessableEntityError
+	_ = json.Unmarshal(body, &data)
+	if len(data.Error.Messages) == 0 {
+		return errors.New("StatusUnprocessableEntity(422): " + string(body))
+	}
+	return errors.New("StatusUnprocessableEntity(422): " + data.Error.Messages[0].EnUS)
+}
@@ -0,0 +1,27 @@
+package unisphere
+
+type EthernetPortInstances = Instances[EthernetPortContent]
+
+type EthernetPortContent struct {
+	Id               string      `json:"id,omitempty"`
+	Health           Health      `json:"health,omitempty"`
+	Name             string      `json:"name,omitempty"`
+	PortNumber       int         `json:"portNumber,omitempty"`
+	Speed            int         `json:"speed,omitempty"`
+	RequestedSpeed   int         `json:"requestedSpeed,omitempty"`
+	Mtu              int         `json:"mtu,omitempty"`
+	IsLinkUp         bool        `json:"isLinkUp,omitempty"`
+	MacAddress       string      `json:"macAddress,omitempty"`
+	NeedsReplacement bool        `json:"needsReplacement,omitempty"`
+	StorageProcessor ResourceRef `json:"storageProcessor,omitempty"`
+}
+
+// SpeedGbps
+// speed (EPSpeedValuesEnum) 는 Mbps 단위 값 (0: Auto 또는 link down)
+func (e *EthernetPortContent) SpeedGbps() float64 {
+	return float64(e.Speed) / 1000
+}
+
+func (c *UnisphereClient) GetEthernetPortInstances(fields []string, filter *Filter) (*EthernetPortInstances, error) {
+	return getInstances[EthernetPortContent](c, "ethernetPort", fields, filter)
+}
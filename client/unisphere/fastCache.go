@@ -0,0 +1,16 @@
+package unisphere
+
+type FastCacheInstances = Instances[FastCacheContent]
+
+type FastCacheContent struct {
+	Id            string `json:"id,omitempty"`
+	Health        Health `json:"health,omitempty"`
+	SizeTotal     Size   `json:"sizeTotal,omitempty"`
+	SizeFree      Size   `json:"sizeFree,omitempty"`
+	NumberOfDisks int64  `json:"numberOfDisks,omitempty"`
+	RaidLevel     int    `json:"raidLevel,omitempty"`
+}
+
+func (c *UnisphereClient) GetFastCacheInstances(fields []string, filter *Filter) (*FastCacheInstances, error) {
+	return getInstances[FastCacheContent](c, "fastCache", fields, filter)
+}
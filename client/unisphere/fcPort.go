@@ -0,0 +1,31 @@
+package unisphere
+
+type FcPortInstances = Instances[FcPortContent]
+
+type FcPortContent struct {
+	Id               string      `json:"id,omitempty"`
+	Health           Health      `json:"health,omitempty"`
+	Name             string      `json:"name,omitempty"`
+	SlotNumber       int         `json:"slotNumber,omitempty"`
+	Wwn              string      `json:"wwn,omitempty"`
+	CurrentSpeed     int         `json:"currentSpeed,omitempty"`
+	RequestedSpeed   int         `json:"requestedSpeed,omitempty"`
+	ConnectorType    int         `json:"connectorType,omitempty"`
+	StorageProcessor ResourceRef `json:"storageProcessor,omitempty"`
+}
+
+// SpeedGbps
+// currentSpeed (FcSpeedEnum) 는 Gbps 단위 값 (0: Auto 또는 link down)
+func (f *FcPortContent) SpeedGbps() float64 {
+	return float64(f.CurrentSpeed)
+}
+
+// IsLinkUp
+// fcPort 에는 link 상태 항목이 없으므로, 협상된 속도(currentSpeed)가 있으면 link up 으로 판단합니다.
+func (f *FcPortContent) IsLinkUp() bool {
+	return f.CurrentSpeed > 0
+}
+
+func (c *UnisphereClient) GetFcPortInstances(fields []string, filter *Filter) (*FcPortInstances, error) {
+	return getInstances[FcPortContent](c, "fcPort", fields, filter)
+}
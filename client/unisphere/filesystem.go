@@ -1,28 +1,26 @@
 package unisphere
 
-import (
-	"encoding/json"
-	"strings"
-	"time"
-)
-
-type FilesystemInstances struct {
-	Base    string    `json:"@base"`
-	Updated time.Time `json:"updated"`
-	Entries []struct {
-		Content FilesystemContent `json:"content"`
-	} `json:"entries"`
-}
+type FilesystemInstances = Instances[FilesystemContent]
 
 type FilesystemContent struct {
-	Id            string             `json:"id,omitempty"`
-	Health        Health             `json:"health,omitempty"`
-	Name          string             `json:"name,omitempty"`
-	Description   string             `json:"description,omitempty"`
-	Type          FilesystemTypeEnum `json:"type,omitempty"`
-	SizeTotal     int64              `json:"sizeTotal,omitempty"`
-	SizeUsed      int64              `json:"sizeUsed,omitempty"`
-	SizeAllocated int64              `json:"sizeAllocated,omitempty"`
+	Id                     string             `json:"id,omitempty"`
+	Health                 Health             `json:"health,omitempty"`
+	Name                   string             `json:"name,omitempty"`
+	Description            string             `json:"description,omitempty"`
+	Type                   FilesystemTypeEnum `json:"type,omitempty"`
+	SizeTotal              Size               `json:"sizeTotal,omitempty"`
+	SizeUsed               Size               `json:"sizeUsed,omitempty"`
+	SizeAllocated          Size               `json:"sizeAllocated,omitempty"`
+	SizePreallocated       Size               `json:"sizePreallocated,omitempty"`
+	IsReadOnly             bool               `json:"isReadOnly,omitempty"`
+	IsThinEnabled          bool               `json:"isThinEnabled,omitempty"`
+	IsDataReductionEnabled bool               `json:"isDataReductionEnabled,omitempty"`
+	DataReductionSizeSaved Size               `json:"dataReductionSizeSaved,omitempty"`
+	DataReductionPercent   int64              `json:"dataReductionPercent,omitempty"`
+	DataReductionRatio     float64            `json:"dataReductionRatio,omitempty"`
+	StorageResource        ResourceRef        `json:"storageResource,omitempty"`
+	Pool                   ResourceRef        `json:"pool,omitempty"`
+	NasServer              ResourceRef        `json:"nasServer,omitempty"`
 }
 
 type FilesystemTypeEnum int
@@ -32,18 +30,6 @@ const (
 	FilesystemTypeVMware
 )
 
-func (c *UnisphereClient) GetFilesystem(fields []string) (*FilesystemInstances, error) {
-	joinedFields := strings.Join(fields, ",")
-	body, err := c.get("/api/types/filesystem/instances?compact=true&fields=" + joinedFields)
-	if err != nil {
-		return nil, err
-	}
-
-	var data FilesystemInstances
-	err = json.Unmarshal(body, &data)
-	if err != nil {
-		return nil, err
-	}
-
-	return &data, nil
+func (c *UnisphereClient) GetFilesystemInstances(fields []string, filter *Filter) (*FilesystemInstances, error) {
+	return getInstances[FilesystemContent](c, "filesystem", fields, filter)
 }
@@ -0,0 +1,105 @@
+package unisphere
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Filter
+// Unisphere REST API 의 filter 파라미터를 생성합니다.
+// 각 조건은 "and" 로 연결됩니다.
+//
+//	NewFilter().Eq("isRealtimeAvailable", true).Gt("creationTime", ctime)
+//	=> isRealtimeAvailable eq true and creationTime gt "2006-01-02T15:04:05.000Z"
+type Filter struct {
+	conditions []string
+}
+
+func NewFilter() *Filter {
+	return &Filter{}
+}
+
+func (f *Filter) Eq(field string, value any) *Filter {
+	return f.add(field, "eq", value)
+}
+
+func (f *Filter) Ne(field string, value any) *Filter {
+	return f.add(field, "ne", value)
+}
+
+func (f *Filter) Gt(field string, value any) *Filter {
+	return f.add(field, "gt", value)
+}
+
+func (f *Filter) Ge(field string, value any) *Filter {
+	return f.add(field, "ge", value)
+}
+
+func (f *Filter) Lt(field string, value any) *Filter {
+	return f.add(field, "lt", value)
+}
+
+func (f *Filter) Le(field string, value any) *Filter {
+	return f.add(field, "le", value)
+}
+
+// Like
+// pattern 에는 와일드카드(*) 를 사용할 수 있습니다.
+func (f *Filter) Like(field string, pattern string) *Filter {
+	return f.add(field, "lk", pattern)
+}
+
+// In
+// field 값이 values 중 하나와 일치하는 조건을 추가합니다.
+func (f *Filter) In(field string, values ...any) *Filter {
+	if len(values) == 0 {
+		return f
+	}
+	var conditions []string
+	for _, v := range values {
+		conditions = append(conditions, field+" eq "+filterValue(v))
+	}
+	f.conditions = append(f.conditions, "("+strings.Join(conditions, " or ")+")")
+	return f
+}
+
+func (f *Filter) add(field string, op string, value any) *Filter {
+	f.conditions = append(f.conditions, field+" "+op+" "+filterValue(value))
+	return f
+}
+
+func (f *Filter) String() string {
+	if f == nil {
+		return ""
+	}
+	return strings.Join(f.conditions, " and ")
+}
+
+func filterValue(value any) string {
+	switch v := value.(type) {
+	case string:
+		return strconv.Quote(v)
+	case time.Time:
+		return "\"" + v.UTC().Format("2006-01-02T15:04:05.000Z") + "\""
+	}
+
+	// enum 타입(HealthEnum, SeverityEnum 등)은 String() 이 아닌 숫자 값으로 비교
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'f', -1, 64)
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool())
+	case reflect.String:
+		return strconv.Quote(rv.String())
+	default:
+		return strconv.Quote(fmt.Sprint(value))
+	}
+}
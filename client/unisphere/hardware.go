@@ -0,0 +1,110 @@
+package unisphere
+
+// HardwareContent
+// disk, dae, dpe, lcc, powerSupply, fan, battery, ssd 에 공통으로 존재하는 속성
+type HardwareContent struct {
+	Id               string      `json:"id,omitempty"`
+	Health           Health      `json:"health,omitempty"`
+	NeedsReplacement bool        `json:"needsReplacement,omitempty"`
+	SlotNumber       int         `json:"slotNumber,omitempty"`
+	Name             string      `json:"name,omitempty"`
+	Manufacturer     string      `json:"manufacturer,omitempty"`
+	Model            string      `json:"model,omitempty"`
+	EmcPartNumber    string      `json:"emcPartNumber,omitempty"`
+	EmcSerialNumber  string      `json:"emcSerialNumber,omitempty"`
+	VendorPartNumber string      `json:"vendorPartNumber,omitempty"`
+	ParentDae        ResourceRef `json:"parentDae,omitempty"`
+	ParentDpe        ResourceRef `json:"parentDpe,omitempty"`
+	ParentSP         ResourceRef `json:"parentStorageProcessor,omitempty"`
+}
+
+// HardwareFields
+// HardwareContent 조회 시 공통으로 요청할 fields
+// 상위 component 는 resource 에 따라 parentDae, parentDpe(disk, lcc, powerSupply, fan) 또는
+// parentStorageProcessor(battery, ssd) 를 추가로 요청해야 합니다.
+var HardwareFields = []string{
+	"health", "needsReplacement", "slotNumber", "name", "emcPartNumber", "emcSerialNumber",
+}
+
+// Parent
+// 상위 component(DAE, DPE 또는 SP) 의 id
+func (h *HardwareContent) Parent() string {
+	switch {
+	case h.ParentDae.Id != "":
+		return h.ParentDae.Id
+	case h.ParentDpe.Id != "":
+		return h.ParentDpe.Id
+	default:
+		return h.ParentSP.Id
+	}
+}
+
+type DiskInstances = Instances[DiskContent]
+
+type DiskContent struct {
+	HardwareContent
+	TierType TierTypeEnum `json:"tierType,omitempty"`
+	Size     Size         `json:"size,omitempty"`
+	RawSize  Size         `json:"rawSize,omitempty"`
+	Pool     ResourceRef  `json:"pool,omitempty"`
+	IsInUse  bool         `json:"isInUse,omitempty"`
+	Wwn      string       `json:"wwn,omitempty"`
+}
+
+type DaeInstances = Instances[DaeContent]
+
+type DaeContent struct {
+	HardwareContent
+	BusId              int `json:"busId,omitempty"`
+	CurrentPower       int `json:"currentPower,omitempty"`
+	CurrentTemperature int `json:"currentTemperature,omitempty"`
+}
+
+type DpeInstances = Instances[DpeContent]
+
+type DpeContent struct {
+	HardwareContent
+	CurrentPower       int `json:"currentPower,omitempty"`
+	CurrentTemperature int `json:"currentTemperature,omitempty"`
+}
+
+type LccInstances = Instances[HardwareContent]
+type PowerSupplyInstances = Instances[HardwareContent]
+type FanInstances = Instances[HardwareContent]
+type BatteryInstances = Instances[HardwareContent]
+type SsdInstances = Instances[HardwareContent]
+
+func (c *UnisphereClient) GetDiskInstances(fields []string, filter *Filter) (*DiskInstances, error) {
+	return getInstances[DiskContent](c, "disk", fields, filter)
+}
+
+func (c *UnisphereClient) GetDaeInstances(fields []string, filter *Filter) (*DaeInstances, error) {
+	return getInstances[DaeContent](c, "dae", fields, filter)
+}
+
+func (c *UnisphereClient) GetDpeInstances(fields []string, filter *Filter) (*DpeInstances, error) {
+	return getInstances[DpeContent](c, "dpe", fields, filter)
+}
+
+func (c *UnisphereClient) GetLccInstances(fields []string, filter *Filter) (*LccInstances, error) {
+	return getInstances[HardwareContent](c, "lcc", fields, filter)
+}
+
+func (c *UnisphereClient) GetPowerSupplyInstances(fields []string, filter *Filter) (*PowerSupplyInstances, error) {
+	return getInstances[HardwareContent](c, "powerSupply", fields, filter)
+}
+
+func (c *UnisphereClient) GetFanInstances(fields []string, filter *Filter) (*FanInstances, error) {
+	return getInstances[HardwareContent](c, "fan", fields, filter)
+}
+
+func (c *UnisphereClient) GetBatteryInstances(fields []string, filter *Filter) (*BatteryInstances, error) {
+	return getInstances[HardwareContent](c, "battery", fields, filter)
+}
+
+// GetSsdInstances
+// ssd 는 SP 내부의 시스템 SSD 이며, REST API 에는 wear level(수명) 항목이 없습니다.
+// 마모로 인한 교체 필요 여부는 health, needsReplacement 로만 확인할 수 있습니다.
+func (c *UnisphereClient) GetSsdInstances(fields []string, filter *Filter) (*SsdInstances, error) {
+	return getInstances[HardwareContent](c, "ssd", fields, filter)
+}
@@ -0,0 +1,48 @@
+package unisphere
+
+type HostInstances = Instances[HostContent]
+
+type HostContent struct {
+	Id            string       `json:"id,omitempty"`
+	Health        Health       `json:"health,omitempty"`
+	Name          string       `json:"name,omitempty"`
+	Description   string       `json:"description,omitempty"`
+	Type          HostTypeEnum `json:"type,omitempty"`
+	OsType        string       `json:"osType,omitempty"`
+	HostContainer ResourceRef  `json:"hostContainer,omitempty"`
+}
+
+type HostTypeEnum int
+
+const (
+	HostTypeUnknown          HostTypeEnum = 0
+	HostTypeHostManual       HostTypeEnum = 1
+	HostTypeSubnet           HostTypeEnum = 2
+	HostTypeNetGroup         HostTypeEnum = 3
+	HostTypeRPA              HostTypeEnum = 4
+	HostTypeHostAuto         HostTypeEnum = 5
+	HostTypeVNXSanCopyTarget HostTypeEnum = 255
+)
+
+func (h HostTypeEnum) String() string {
+	switch h {
+	case HostTypeHostManual:
+		return "HostManual"
+	case HostTypeSubnet:
+		return "Subnet"
+	case HostTypeNetGroup:
+		return "NetGroup"
+	case HostTypeRPA:
+		return "RPA"
+	case HostTypeHostAuto:
+		return "HostAuto"
+	case HostTypeVNXSanCopyTarget:
+		return "VNXSanCopyTarget"
+	default:
+		return "Unknown"
+	}
+}
+
+func (c *UnisphereClient) GetHostInstances(fields []string, filter *Filter) (*HostInstances, error) {
+	return getInstances[HostContent](c, "host", fields, filter)
+}
@@ -0,0 +1,16 @@
+package unisphere
+
+type HostContainerInstances = Instances[HostContainerContent]
+
+type HostContainerContent struct {
+	Id           string `json:"id,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Description  string `json:"description,omitempty"`
+	SerialNumber string `json:"serialNumber,omitempty"`
+	Type         int    `json:"type,omitempty"`
+	Address      string `json:"address,omitempty"`
+}
+
+func (c *UnisphereClient) GetHostContainerInstances(fields []string, filter *Filter) (*HostContainerInstances, error) {
+	return getInstances[HostContainerContent](c, "hostContainer", fields, filter)
+}
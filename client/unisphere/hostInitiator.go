@@ -0,0 +1,36 @@
+package unisphere
+
+type HostInitiatorInstances = Instances[HostInitiatorContent]
+
+type HostInitiatorContent struct {
+	Id          string                `json:"id,omitempty"`
+	Health      Health                `json:"health,omitempty"`
+	Type        HostInitiatorTypeEnum `json:"type,omitempty"`
+	InitiatorId string                `json:"initiatorId,omitempty"`
+	ParentHost  ResourceRef           `json:"parentHost,omitempty"`
+	IsIgnored   bool                  `json:"isIgnored,omitempty"`
+	Paths       []ResourceRef         `json:"paths,omitempty"`
+}
+
+type HostInitiatorTypeEnum int
+
+const (
+	HostInitiatorTypeUnknown HostInitiatorTypeEnum = iota
+	HostInitiatorTypeFC
+	HostInitiatorTypeISCSI
+)
+
+func (h HostInitiatorTypeEnum) String() string {
+	switch h {
+	case HostInitiatorTypeFC:
+		return "FC"
+	case HostInitiatorTypeISCSI:
+		return "iSCSI"
+	default:
+		return "Unknown"
+	}
+}
+
+func (c *UnisphereClient) GetHostInitiatorInstances(fields []string, filter *Filter) (*HostInitiatorInstances, error) {
+	return getInstances[HostInitiatorContent](c, "hostInitiator", fields, filter)
+}
@@ -0,0 +1,25 @@
+package unisphere
+
+type HostLUNInstances = Instances[HostLUNContent]
+
+type HostLUNContent struct {
+	Id         string          `json:"id,omitempty"`
+	Host       ResourceRef     `json:"host,omitempty"`
+	Type       HostLUNTypeEnum `json:"type,omitempty"`
+	Hlu        int             `json:"hlu,omitempty"`
+	Lun        ResourceRef     `json:"lun,omitempty"`
+	Snap       ResourceRef     `json:"snap,omitempty"`
+	IsReadOnly bool            `json:"isReadOnly,omitempty"`
+}
+
+type HostLUNTypeEnum int
+
+const (
+	HostLUNTypeUnknown HostLUNTypeEnum = iota
+	HostLUNTypeLUN
+	HostLUNTypeLUNSnap
+)
+
+func (c *UnisphereClient) GetHostLUNInstances(fields []string, filter *Filter) (*HostLUNInstances, error) {
+	return getInstances[HostLUNContent](c, "hostLUN", fields, filter)
+}
@@ -0,0 +1,89 @@
+package unisphere
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Unisphere 에서 한 번에 조회할 수 있는 최대 instance 수
+const perPage = 2000
+
+type Link struct {
+	Rel  string `json:"rel"`
+	Href string `json:"href"`
+}
+
+// Instances
+// /api/types/<type>/instances 조회 결과
+type Instances[T any] struct {
+	Base    string    `json:"@base"`
+	Updated time.Time `json:"updated"`
+	Links   []Link    `json:"links,omitempty"`
+	Entries []struct {
+		Content T `json:"content"`
+	} `json:"entries"`
+}
+
+// nextPage
+// links 의 rel=next 항목에서 다음 page 번호를 가져옵니다. 다음 page 가 없으면 0 을 반환합니다.
+func (i *Instances[T]) nextPage(page int) int {
+	for _, link := range i.Links {
+		if link.Rel != "next" {
+			continue
+		}
+		values, err := url.ParseQuery(strings.TrimLeft(link.Href, "?&"))
+		if err == nil {
+			if next, err := strconv.Atoi(values.Get("page")); err == nil && next > page {
+				return next
+			}
+		}
+		return page + 1
+	}
+	return 0
+}
+
+// getInstances
+// next link 를 따라 모든 page 를 조회하여 하나의 Instances 로 반환합니다.
+func getInstances[T any](c *UnisphereClient, typeName string, fields []string, filter *Filter) (*Instances[T], error) {
+	query := "/api/types/" + typeName + "/instances?compact=true&per_page=" + strconv.Itoa(perPage)
+	if len(fields) != 0 {
+		query += "&fields=" + strings.Join(fields, ",")
+	}
+	if s := filter.String(); s != "" {
+		query += "&filter=" + escapeQuery(s)
+	}
+
+	var result *Instances[T]
+	for page := 1; page > 0; {
+		body, err := c.get(query + "&page=" + strconv.Itoa(page))
+		if err != nil {
+			return nil, err
+		}
+
+		var data Instances[T]
+		err = json.Unmarshal(body, &data)
+		if err != nil {
+			return nil, err
+		}
+
+		if result == nil {
+			result = &data
+		} else {
+			result.Entries = append(result.Entries, data.Entries...)
+		}
+		if len(data.Entries) == 0 {
+			break
+		}
+		page = data.nextPage(page)
+	}
+	result.Links = nil
+
+	return result, nil
+}
+
+func escapeQuery(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
@@ -0,0 +1,18 @@
+package unisphere
+
+type IscsiPortalInstances = Instances[IscsiPortalContent]
+
+type IscsiPortalContent struct {
+	Id                string      `json:"id,omitempty"`
+	EthernetPort      ResourceRef `json:"ethernetPort,omitempty"`
+	IscsiNode         ResourceRef `json:"iscsiNode,omitempty"`
+	IpAddress         string      `json:"ipAddress,omitempty"`
+	Netmask           string      `json:"netmask,omitempty"`
+	Gateway           string      `json:"gateway,omitempty"`
+	VlanId            int         `json:"vlanId,omitempty"`
+	IpProtocolVersion int         `json:"ipProtocolVersion,omitempty"`
+}
+
+func (c *UnisphereClient) GetIscsiPortalInstances(fields []string, filter *Filter) (*IscsiPortalInstances, error) {
+	return getInstances[IscsiPortalContent](c, "iscsiPortal", fields, filter)
+}
@@ -1,18 +1,6 @@
 package unisphere
 
-import (
-	"encoding/json"
-	"strings"
-	"time"
-)
-
-type LunInstances struct {
-	Base    string    `json:"@base"`
-	Updated time.Time `json:"updated"`
-	Entries []struct {
-		Content LunContent `json:"content"`
-	} `json:"entries"`
-}
+type LunInstances = Instances[LunContent]
 
 type LunContent struct {
 	Id                     string      `json:"id,omitempty"`
@@ -20,12 +8,12 @@ type LunContent struct {
 	Name                   string      `json:"name,omitempty"`
 	Description            string      `json:"description,omitempty"`
 	Type                   LunTypeEnum `json:"type,omitempty"`
-	SizeTotal              int64       `json:"sizeTotal,omitempty"`
-	SizeUsed               int64       `json:"sizeUsed,omitempty"`
-	SizeAllocated          int64       `json:"sizeAllocated,omitempty"`
-	SizePreallocated       int64       `json:"sizePreallocated,omitempty"`
-	SizeAllocatedTotal     int64       `json:"sizeAllocatedTotal,omitempty"`
-	DataReductionSizeSaved int64       `json:"dataReductionSizeSaved,omitempty"`
+	SizeTotal              Size        `json:"sizeTotal,omitempty"`
+	SizeUsed               Size        `json:"sizeUsed,omitempty"`
+	SizeAllocated          Size        `json:"sizeAllocated,omitempty"`
+	SizePreallocated       Size        `json:"sizePreallocated,omitempty"`
+	SizeAllocatedTotal     Size        `json:"sizeAllocatedTotal,omitempty"`
+	DataReductionSizeSaved Size        `json:"dataReductionSizeSaved,omitempty"`
 	DataReductionPercent   int64       `json:"dataReductionPercent,omitempty"`
 	DataReductionRatio     int64       `json:"dataReductionRatio,omitempty"`
 	IsThinEnabled          bool        `json:"isThinEnabled,omitempty"`
@@ -42,21 +30,6 @@ const (
 	LunTypeVmWareISCSI
 )
 
-func (c *UnisphereClient) GetLun(fields []string) (*LunInstances, error) {
-	path := "/api/types/lun/instances?compact=true"
-	if len(fields) != 0 {
-		path += "&fields=" + strings.Join(fields, ",")
-	}
-	body, err := c.get(path)
-	if err != nil {
-		return nil, err
-	}
-
-	var data LunInstances
-	err = json.Unmarshal(body, &data)
-	if err != nil {
-		return nil, err
-	}
-
-	return &data, nil
+func (c *UnisphereClient) GetLunInstances(fields []string, filter *Filter) (*LunInstances, error) {
+	return getInstances[LunContent](c, "lun", fields, filter)
 }
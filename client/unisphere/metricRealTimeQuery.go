@@ -2,7 +2,6 @@ package unisphere
 
 import (
 	"encoding/json"
-	"strconv"
 	"time"
 )
 
@@ -10,11 +9,11 @@ type MetricRealTimeQueryInstances struct {
 	Base    string    `json:"@base"`
 	Updated time.Time `json:"updated"`
 	Content struct {
-		Id int64 `json:"id"`
+		Id int `json:"id"`
 	} `json:"content"`
 }
 
-func (c *UnisphereClient) PostMetricRealTimeQuery(paths []string, interval time.Duration) (string, error) {
+func (c *UnisphereClient) PostMetricRealTimeQueryInstances(paths []string, interval time.Duration) (*MetricRealTimeQueryInstances, error) {
 	path := "/api/types/metricRealTimeQuery/instances"
 	var reqBodySt struct {
 		Paths    []string `json:"paths"`
@@ -25,19 +24,19 @@ func (c *UnisphereClient) PostMetricRealTimeQuery(paths []string, interval time.
 
 	reqBody, err := json.Marshal(reqBodySt)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	body, err := c.post(path, reqBody)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	var data MetricRealTimeQueryInstances
 	err = json.Unmarshal(body, &data)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return strconv.Itoa(int(data.Content.Id)), nil
+	return &data, nil
 }
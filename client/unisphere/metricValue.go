@@ -0,0 +1,24 @@
+package unisphere
+
+import "time"
+
+type MetricValueInstances = Instances[MetricValueContent]
+
+// MetricValueContent
+// 이력(historical) 메트릭 샘플입니다.
+// Values 의 구조는 MetricQueryResultContent 와 같습니다.
+type MetricValueContent struct {
+	Path      string      `json:"path,omitempty"`
+	Timestamp time.Time   `json:"timestamp,omitempty"`
+	Interval  int         `json:"interval,omitempty"`
+	Values    interface{} `json:"values,omitempty"`
+}
+
+// GetMetricValueInstances
+//
+// filter 에는 path 조건이 반드시 필요하며, 한 번에 하나의 path 만 조회할 수 있습니다.
+// interval 은 시스템의 수집 주기(60, 300, 3600, 14400 초) 중 하나입니다.
+// ex) filter : NewFilter().Eq("path", "sp.*.cpu.summary.utilization").Eq("interval", 300).Gt("timestamp", since)
+func (c *UnisphereClient) GetMetricValueInstances(fields []string, filter *Filter) (*MetricValueInstances, error) {
+	return getInstances[MetricValueContent](c, "metricValue", fields, filter)
+}
@@ -0,0 +1,56 @@
+package unisphere
+
+type NasServerInstances = Instances[NasServerContent]
+
+type NasServerContent struct {
+	Id                       string              `json:"id,omitempty"`
+	Health                   Health              `json:"health,omitempty"`
+	Name                     string              `json:"name,omitempty"`
+	HomeSP                   ResourceRef         `json:"homeSP,omitempty"`
+	CurrentSP                ResourceRef         `json:"currentSP,omitempty"`
+	Pool                     ResourceRef         `json:"pool,omitempty"`
+	SizeAllocated            Size                `json:"sizeAllocated,omitempty"`
+	IsReplicationEnabled     bool                `json:"isReplicationEnabled,omitempty"`
+	IsReplicationDestination bool                `json:"isReplicationDestination,omitempty"`
+	ReplicationType          ReplicationTypeEnum `json:"replicationType,omitempty"`
+	IsMultiProtocolEnabled   bool                `json:"isMultiProtocolEnabled,omitempty"`
+}
+
+// ReplicationRole
+// 복제 설정이 없으면 "None", 복제 대상이면 "Destination", 그 외에는 "Source"
+func (n *NasServerContent) ReplicationRole() string {
+	switch {
+	case !n.IsReplicationEnabled:
+		return "None"
+	case n.IsReplicationDestination:
+		return "Destination"
+	default:
+		return "Source"
+	}
+}
+
+type ReplicationTypeEnum int
+
+const (
+	ReplicationTypeNone ReplicationTypeEnum = iota
+	ReplicationTypeLocal
+	ReplicationTypeRemote
+	ReplicationTypeMixed
+)
+
+func (r ReplicationTypeEnum) String() string {
+	switch r {
+	case ReplicationTypeLocal:
+		return "Local"
+	case ReplicationTypeRemote:
+		return "Remote"
+	case ReplicationTypeMixed:
+		return "Mixed"
+	default:
+		return "None"
+	}
+}
+
+func (c *UnisphereClient) GetNasServerInstances(fields []string, filter *Filter) (*NasServerInstances, error) {
+	return getInstances[NasServerContent](c, "nasServer", fields, filter)
+}
@@ -0,0 +1,18 @@
+package unisphere
+
+type NfsShareInstances = Instances[NfsShareContent]
+
+type NfsShareContent struct {
+	Id          string      `json:"id,omitempty"`
+	Name        string      `json:"name,omitempty"`
+	Path        string      `json:"path,omitempty"`
+	ExportPaths []string    `json:"exportPaths,omitempty"`
+	Description string      `json:"description,omitempty"`
+	IsReadOnly  bool        `json:"isReadOnly,omitempty"`
+	Filesystem  ResourceRef `json:"filesystem,omitempty"`
+	Snap        ResourceRef `json:"snap,omitempty"`
+}
+
+func (c *UnisphereClient) GetNfsShareInstances(fields []string, filter *Filter) (*NfsShareInstances, error) {
+	return getInstances[NfsShareContent](c, "nfsShare", fields, filter)
+}
@@ -0,0 +1,140 @@
+package unisphere
+
+import "time"
+
+type PoolInstances = Instances[PoolContent]
+
+type PoolContent struct {
+	Id                          string       `json:"id,omitempty"`
+	Health                      Health       `json:"health,omitempty"`
+	Name                        string       `json:"name,omitempty"`
+	Description                 string       `json:"description,omitempty"`
+	Type                        PoolTypeEnum `json:"type,omitempty"`
+	SizeFree                    Size         `json:"sizeFree,omitempty"`
+	SizeTotal                   Size         `json:"sizeTotal,omitempty"`
+	SizeUsed                    Size         `json:"sizeUsed,omitempty"`
+	SizePreallocated            Size         `json:"sizePreallocated,omitempty"`
+	SizeSubscribed              Size         `json:"sizeSubscribed,omitempty"`
+	DataReductionSizeSaved      Size         `json:"dataReductionSizeSaved,omitempty"`
+	DataReductionPercent        int64        `json:"dataReductionPercent,omitempty"`
+	DataReductionRatio          float64      `json:"dataReductionRatio,omitempty"`
+	AlertThreshold              int64        `json:"alertThreshold,omitempty"`
+	IsFASTCacheEnabled          bool         `json:"isFASTCacheEnabled,omitempty"`
+	IsAllFlash                  bool         `json:"isAllFlash,omitempty"`
+	CreationTime                time.Time    `json:"creationTime,omitempty"`
+	PoolFastVP                  PoolFastVP   `json:"poolFastVP,omitempty"`
+	Tiers                       []PoolTier   `json:"tiers,omitempty"`
+	HasDataReductionEnabledLuns bool         `json:"hasDataReductionEnabledLuns,omitempty"`
+	HasDataReductionEnabledFs   bool         `json:"hasDataReductionEnabledFs,omitempty"`
+}
+
+type PoolFastVP struct {
+	Status                     FastVPStatusEnum         `json:"status,omitempty"`
+	RelocationRate             FastVPRelocationRateEnum `json:"relocationRate,omitempty"`
+	IsScheduleEnabled          bool                     `json:"isScheduleEnabled,omitempty"`
+	RelocationDurationEstimate string                   `json:"relocationDurationEstimate,omitempty"`
+	SizeMovingDown             Size                     `json:"sizeMovingDown,omitempty"`
+	SizeMovingUp               Size                     `json:"sizeMovingUp,omitempty"`
+	SizeMovingWithin           Size                     `json:"sizeMovingWithin,omitempty"`
+	PercentComplete            int64                    `json:"percentComplete,omitempty"`
+	DataRelocated              Size                     `json:"dataRelocated,omitempty"`
+	LastStartTime              time.Time                `json:"lastStartTime,omitempty"`
+	LastEndTime                time.Time                `json:"lastEndTime,omitempty"`
+}
+
+type PoolTier struct {
+	TierType         TierTypeEnum `json:"tierType,omitempty"`
+	Name             string       `json:"name,omitempty"`
+	RaidType         int          `json:"raidType,omitempty"`
+	StripeWidth      int          `json:"stripeWidth,omitempty"`
+	SizeTotal        Size         `json:"sizeTotal,omitempty"`
+	SizeUsed         Size         `json:"sizeUsed,omitempty"`
+	SizeFree         Size         `json:"sizeFree,omitempty"`
+	SizeMovingDown   Size         `json:"sizeMovingDown,omitempty"`
+	SizeMovingUp     Size         `json:"sizeMovingUp,omitempty"`
+	SizeMovingWithin Size         `json:"sizeMovingWithin,omitempty"`
+	DiskCount        int64        `json:"diskCount,omitempty"`
+}
+
+type PoolTypeEnum int
+
+const (
+	PoolTypeTraditional PoolTypeEnum = iota + 1
+	PoolTypeDynamic
+)
+
+func (p PoolTypeEnum) String() string {
+	switch p {
+	case PoolTypeTraditional:
+		return "Traditional"
+	case PoolTypeDynamic:
+		return "Dynamic"
+	default:
+		return "Unknown"
+	}
+}
+
+type FastVPStatusEnum int
+
+const (
+	FastVPStatusNotApplicable FastVPStatusEnum = iota + 1
+	FastVPStatusPaused
+	FastVPStatusActive
+	FastVPStatusNotStarted
+	FastVPStatusCompleted
+	FastVPStatusStoppedByUser
+	FastVPStatusFailed
+)
+
+type FastVPRelocationRateEnum int
+
+const (
+	FastVPRelocationRateHigh FastVPRelocationRateEnum = iota + 1
+	FastVPRelocationRateMedium
+	FastVPRelocationRateLow
+	FastVPRelocationRateNone
+)
+
+func (r FastVPRelocationRateEnum) String() string {
+	switch r {
+	case FastVPRelocationRateHigh:
+		return "High"
+	case FastVPRelocationRateMedium:
+		return "Medium"
+	case FastVPRelocationRateLow:
+		return "Low"
+	case FastVPRelocationRateNone:
+		return "None"
+	default:
+		return "Unknown"
+	}
+}
+
+type TierTypeEnum int
+
+const (
+	TierTypeNone               TierTypeEnum = 0
+	TierTypeExtremePerformance TierTypeEnum = 10
+	TierTypePerformance        TierTypeEnum = 20
+	TierTypeCapacity           TierTypeEnum = 30
+	TierTypeMixed              TierTypeEnum = 40
+)
+
+func (t TierTypeEnum) String() string {
+	switch t {
+	case TierTypeExtremePerformance:
+		return "Extreme_Performance"
+	case TierTypePerformance:
+		return "Performance"
+	case TierTypeCapacity:
+		return "Capacity"
+	case TierTypeMixed:
+		return "Mixed"
+	default:
+		return "None"
+	}
+}
+
+func (c *UnisphereClient) GetPoolInstances(fields []string, filter *Filter) (*PoolInstances, error) {
+	return getInstances[PoolContent](c, "pool", fields, filter)
+}
@@ -0,0 +1,37 @@
+package unisphere
+
+type PoolUnitInstances = Instances[PoolUnitContent]
+
+type PoolUnitContent struct {
+	Id          string           `json:"id,omitempty"`
+	Type        PoolUnitTypeEnum `json:"type,omitempty"`
+	Health      Health           `json:"health,omitempty"`
+	Name        string           `json:"name,omitempty"`
+	Description string           `json:"description,omitempty"`
+	Wwn         string           `json:"wwn,omitempty"`
+	SizeTotal   Size             `json:"sizeTotal,omitempty"`
+	TierType    TierTypeEnum     `json:"tierType,omitempty"`
+	Pool        ResourceRef      `json:"pool,omitempty"`
+}
+
+type PoolUnitTypeEnum int
+
+const (
+	PoolUnitTypeRaidGroup PoolUnitTypeEnum = iota + 1
+	PoolUnitTypeVirtualDisk
+)
+
+func (p PoolUnitTypeEnum) String() string {
+	switch p {
+	case PoolUnitTypeRaidGroup:
+		return "RAID_Group"
+	case PoolUnitTypeVirtualDisk:
+		return "Virtual_Disk"
+	default:
+		return "Unknown"
+	}
+}
+
+func (c *UnisphereClient) GetPoolUnitInstances(fields []string, filter *Filter) (*PoolUnitInstances, error) {
+	return getInstances[PoolUnitContent](c, "poolUnit", fields, filter)
+}
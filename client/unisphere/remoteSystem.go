@@ -0,0 +1,17 @@
+package unisphere
+
+type RemoteSystemInstances = Instances[RemoteSystemContent]
+
+type RemoteSystemContent struct {
+	Id                string `json:"id,omitempty"`
+	Health            Health `json:"health,omitempty"`
+	Name              string `json:"name,omitempty"`
+	Model             string `json:"model,omitempty"`
+	SerialNumber      string `json:"serialNumber,omitempty"`
+	ManagementAddress string `json:"managementAddress,omitempty"`
+	ConnectionType    int    `json:"connectionType,omitempty"`
+}
+
+func (c *UnisphereClient) GetRemoteSystemInstances(fields []string, filter *Filter) (*RemoteSystemInstances, error) {
+	return getInstances[RemoteSystemContent](c, "remoteSystem", fields, filter)
+}
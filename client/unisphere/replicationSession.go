@@ -0,0 +1,95 @@
+package unisphere
+
+import "time"
+
+type ReplicationSessionInstances = Instances[ReplicationSessionContent]
+
+type ReplicationSessionContent struct {
+	Id                           string                      `json:"id,omitempty"`
+	Name                         string                      `json:"name,omitempty"`
+	Health                       Health                      `json:"health,omitempty"`
+	ReplicationResourceType      ReplicationResourceTypeEnum `json:"replicationResourceType,omitempty"`
+	Status                       int                         `json:"status,omitempty"`
+	SyncState                    ReplicationSyncStateEnum    `json:"syncState,omitempty"`
+	LocalRole                    ReplicationRoleEnum         `json:"localRole,omitempty"`
+	MaxTimeOutOfSync             int64                       `json:"maxTimeOutOfSync,omitempty"`
+	LastSyncTime                 time.Time                   `json:"lastSyncTime,omitempty"`
+	SyncProgress                 int64                       `json:"syncProgress,omitempty"`
+	CurrentTransferEstRemainTime int64                       `json:"currentTransferEstRemainTime,omitempty"`
+	SrcResourceId                string                      `json:"srcResourceId,omitempty"`
+	DstResourceId                string                      `json:"dstResourceId,omitempty"`
+	RemoteSystem                 ResourceRef                 `json:"remoteSystem,omitempty"`
+}
+
+type ReplicationResourceTypeEnum int
+
+const (
+	ReplicationResourceTypeFilesystem       ReplicationResourceTypeEnum = 1
+	ReplicationResourceTypeConsistencyGroup ReplicationResourceTypeEnum = 2
+	ReplicationResourceTypeVMwareFS         ReplicationResourceTypeEnum = 3
+	ReplicationResourceTypeVMwareISCSI      ReplicationResourceTypeEnum = 4
+	ReplicationResourceTypeLun              ReplicationResourceTypeEnum = 8
+	ReplicationResourceTypeNasServer        ReplicationResourceTypeEnum = 10000
+)
+
+func (r ReplicationResourceTypeEnum) String() string {
+	switch r {
+	case ReplicationResourceTypeFilesystem:
+		return "Filesystem"
+	case ReplicationResourceTypeConsistencyGroup:
+		return "ConsistencyGroup"
+	case ReplicationResourceTypeVMwareFS:
+		return "VMwareFS"
+	case ReplicationResourceTypeVMwareISCSI:
+		return "VMwareISCSI"
+	case ReplicationResourceTypeLun:
+		return "LUN"
+	case ReplicationResourceTypeNasServer:
+		return "NASServer"
+	default:
+		return "Unknown"
+	}
+}
+
+type ReplicationSyncStateEnum int
+
+const (
+	ReplicationSyncStateManualSyncing ReplicationSyncStateEnum = iota
+	ReplicationSyncStateAutoSyncing
+	ReplicationSyncStateIdleManualSync
+	ReplicationSyncStateIdleAutoSync
+	ReplicationSyncStateOutOfSync
+	ReplicationSyncStateInSync
+	ReplicationSyncStateConsistent
+	ReplicationSyncStateSyncing
+	ReplicationSyncStateInconsistent
+)
+
+type ReplicationRoleEnum int
+
+const (
+	ReplicationRoleSource ReplicationRoleEnum = iota
+	ReplicationRoleDestination
+	ReplicationRoleLoopback
+	ReplicationRoleLocal
+	ReplicationRoleUnknown
+)
+
+func (r ReplicationRoleEnum) String() string {
+	switch r {
+	case ReplicationRoleSource:
+		return "Source"
+	case ReplicationRoleDestination:
+		return "Destination"
+	case ReplicationRoleLoopback:
+		return "Loopback"
+	case ReplicationRoleLocal:
+		return "Local"
+	default:
+		return "Unknown"
+	}
+}
+
+func (c *UnisphereClient) GetReplicationSessionInstances(fields []string, filter *Filter) (*ReplicationSessionInstances, error) {
+	return getInstances[ReplicationSessionContent](c, "replicationSession", fields, filter)
+}
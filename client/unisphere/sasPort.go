@@ -0,0 +1,16 @@
+package unisphere
+
+type SasPortInstances = Instances[SasPortContent]
+
+type SasPortContent struct {
+	Id               string      `json:"id,omitempty"`
+	Health           Health      `json:"health,omitempty"`
+	Name             string      `json:"name,omitempty"`
+	Port             int         `json:"port,omitempty"`
+	NeedsReplacement bool        `json:"needsReplacement,omitempty"`
+	ParentSP         ResourceRef `json:"parentStorageProcessor,omitempty"`
+}
+
+func (c *UnisphereClient) GetSasPortInstances(fields []string, filter *Filter) (*SasPortInstances, error) {
+	return getInstances[SasPortContent](c, "sasPort", fields, filter)
+}
@@ -0,0 +1,25 @@
+package unisphere
+
+import "time"
+
+type SnapInstances = Instances[SnapContent]
+
+type SnapContent struct {
+	Id              string      `json:"id,omitempty"`
+	Name            string      `json:"name,omitempty"`
+	Description     string      `json:"description,omitempty"`
+	StorageResource ResourceRef `json:"storageResource,omitempty"`
+	Lun             ResourceRef `json:"lun,omitempty"`
+	CreationTime    time.Time   `json:"creationTime,omitempty"`
+	ExpirationTime  time.Time   `json:"expirationTime,omitempty"`
+	CreatorType     int         `json:"creatorType,omitempty"`
+	CreatorSchedule ResourceRef `json:"creatorSchedule,omitempty"`
+	IsSystemSnap    bool        `json:"isSystemSnap,omitempty"`
+	IsAutoDelete    bool        `json:"isAutoDelete,omitempty"`
+	State           int         `json:"state,omitempty"`
+	Size            Size        `json:"size,omitempty"`
+}
+
+func (c *UnisphereClient) GetSnapInstances(fields []string, filter *Filter) (*SnapInstances, error) {
+	return getInstances[SnapContent](c, "snap", fields, filter)
+}
@@ -0,0 +1,15 @@
+package unisphere
+
+type SnapScheduleInstances = Instances[SnapScheduleContent]
+
+type SnapScheduleContent struct {
+	Id               string        `json:"id,omitempty"`
+	Name             string        `json:"name,omitempty"`
+	IsDefault        bool          `json:"isDefault,omitempty"`
+	IsModified       bool          `json:"isModified,omitempty"`
+	StorageResources []ResourceRef `json:"storageResources,omitempty"`
+}
+
+func (c *UnisphereClient) GetSnapScheduleInstances(fields []string, filter *Filter) (*SnapScheduleInstances, error) {
+	return getInstances[SnapScheduleContent](c, "snapSchedule", fields, filter)
+}
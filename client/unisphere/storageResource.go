@@ -0,0 +1,56 @@
+package unisphere
+
+type StorageResourceInstances = Instances[StorageResourceContent]
+
+type StorageResourceContent struct {
+	Id                   string                  `json:"id,omitempty"`
+	Health               Health                  `json:"health,omitempty"`
+	Name                 string                  `json:"name,omitempty"`
+	Description          string                  `json:"description,omitempty"`
+	Type                 StorageResourceTypeEnum `json:"type,omitempty"`
+	SizeTotal            Size                    `json:"sizeTotal,omitempty"`
+	SizeUsed             Size                    `json:"sizeUsed,omitempty"`
+	SizeAllocated        Size                    `json:"sizeAllocated,omitempty"`
+	SnapCount            int64                   `json:"snapCount,omitempty"`
+	SnapsSizeTotal       Size                    `json:"snapsSizeTotal,omitempty"`
+	SnapsSizeAllocated   Size                    `json:"snapsSizeAllocated,omitempty"`
+	SnapSchedule         ResourceRef             `json:"snapSchedule,omitempty"`
+	IsSnapSchedulePaused bool                    `json:"isSnapSchedulePaused,omitempty"`
+}
+
+type StorageResourceTypeEnum int
+
+const (
+	StorageResourceTypeFilesystem         StorageResourceTypeEnum = 1
+	StorageResourceTypeConsistencyGroup   StorageResourceTypeEnum = 2
+	StorageResourceTypeVMwareFS           StorageResourceTypeEnum = 3
+	StorageResourceTypeVMwareISCSI        StorageResourceTypeEnum = 4
+	StorageResourceTypeLun                StorageResourceTypeEnum = 8
+	StorageResourceTypeVVolDatastoreFS    StorageResourceTypeEnum = 9
+	StorageResourceTypeVVolDatastoreISCSI StorageResourceTypeEnum = 10
+)
+
+func (s StorageResourceTypeEnum) String() string {
+	switch s {
+	case StorageResourceTypeFilesystem:
+		return "Filesystem"
+	case StorageResourceTypeConsistencyGroup:
+		return "ConsistencyGroup"
+	case StorageResourceTypeVMwareFS:
+		return "VMwareFS"
+	case StorageResourceTypeVMwareISCSI:
+		return "VMwareISCSI"
+	case StorageResourceTypeLun:
+		return "LUN"
+	case StorageResourceTypeVVolDatastoreFS:
+		return "VVolDatastoreFS"
+	case StorageResourceTypeVVolDatastoreISCSI:
+		return "VVolDatastoreISCSI"
+	default:
+		return "Unknown"
+	}
+}
+
+func (c *UnisphereClient) GetStorageResourceInstances(fields []string, filter *Filter) (*StorageResourceInstances, error) {
+	return getInstances[StorageResourceContent](c, "storageResource", fields, filter)
+}
@@ -1,18 +1,6 @@
 package unisphere
 
-import (
-	"encoding/json"
-	"strings"
-	"time"
-)
-
-type SystemInstances struct {
-	Base    string    `json:"@base"`
-	Updated time.Time `json:"updated"`
-	Entries []struct {
-		Content SystemContent `json:"content"`
-	} `json:"entries"`
-}
+type SystemInstances = Instances[SystemContent]
 
 type SystemContent struct {
 	Id     string `json:"id,omitempty"`
@@ -33,29 +21,13 @@ type SystemContent struct {
 	MacAddress                   string `json:"macAddress,omitempty"`
 	IsEULAAccount                bool   `json:"isEULAAccount,omitempty"`
 	IsUpgradeComplete            bool   `json:"isUpgradeComplete,omitempty"`
-	isAutoFailbackEnabled        bool   `json:"isAutoFailbackEnabled,omitempty"`
+	IsAutoFailbackEnabled        bool   `json:"isAutoFailbackEnabled,omitempty"`
 	CurrentPower                 int32  `json:"currentPower,omitempty"`
 	AvgPower                     int32  `json:"avgPower,omitempty"`
 	SupportedUpgradeModels       []int  `json:"supportedUpgradeModels,omitempty"`
 	IsRemoteSysInterfaceAutoPair bool   `json:"isRemoteSysInterfaceAutoPair,omitempty"`
 }
 
-func (c *UnisphereClient) GetSystem(fields []string) (*SystemInstances, error) {
-	path := "/api/types/system/instances?compact=true"
-	if len(fields) != 0 {
-		path += "&fields=" + strings.Join(fields, ",")
-	}
-
-	body, err := c.get(path)
-	if err != nil {
-		return nil, err
-	}
-
-	var data SystemInstances
-	err = json.Unmarshal(body, &data)
-	if err != nil {
-		return nil, err
-	}
-
-	return &data, nil
+func (c *UnisphereClient) GetSystemInstances(fields []string, filter *Filter) (*SystemInstances, error) {
+	return getInstances[SystemContent](c, "system", fields, filter)
 }
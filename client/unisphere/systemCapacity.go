@@ -1,30 +1,18 @@
 package unisphere
 
-import (
-	"encoding/json"
-	"strings"
-	"time"
-)
-
-type SystemCapacityInstances struct {
-	Base    string    `json:"@base"`
-	Updated time.Time `json:"updated"`
-	Entries []struct {
-		Content SystemCapacityContent `json:"content"`
-	} `json:"entries"`
-}
+type SystemCapacityInstances = Instances[SystemCapacityContent]
 
 type SystemCapacityContent struct {
 	Id                     string  `json:"id,omitempty"`
-	SizeFree               int64   `json:"sizeFree,omitempty"`
-	SizeTotal              int64   `json:"sizeTotal,omitempty"`
-	SizeUsed               int64   `json:"sizeUsed,omitempty"`
-	SizePreallocated       int64   `json:"sizePreallocated,omitempty"`
-	DataReductionSizeSaved int64   `json:"dataReductionSizeSaved,omitempty"`
+	SizeFree               Size    `json:"sizeFree,omitempty"`
+	SizeTotal              Size    `json:"sizeTotal,omitempty"`
+	SizeUsed               Size    `json:"sizeUsed,omitempty"`
+	SizePreallocated       Size    `json:"sizePreallocated,omitempty"`
+	DataReductionSizeSaved Size    `json:"dataReductionSizeSaved,omitempty"`
 	DataReductionPercent   int64   `json:"dataReductionPercent,omitempty"`
 	DataReductionRatio     float64 `json:"dataReductionRatio,omitempty"`
-	SizeSubscribed         int64   `json:"sizeSubscribed,omitempty"`
-	TotalLogicalSize       int64   `json:"totalLogicalSize,omitempty"`
+	SizeSubscribed         Size    `json:"sizeSubscribed,omitempty"`
+	TotalLogicalSize       Size    `json:"totalLogicalSize,omitempty"`
 	ThinSavingRatio        float64 `json:"thinSavingRatio,omitempty"`
 	SnapsSavingsRatio      float64 `json:"snapsSavingsRatio,omitempty"`
 	OverallEfficiencyRatio float64 `json:"overallEfficiencyRatio,omitempty"`
@@ -33,21 +21,9 @@ type SystemCapacityContent struct {
 		SizeFree  int64 `json:"sizeFree,omitempty"`
 		SizeTotal int64 `json:"sizeTotal,omitempty"`
 		SizeUsed  int64 `json:"sizeUsed,omitempty"`
-	} `json:"titers,omitempty"`
+	} `json:"tiers,omitempty"`
 }
 
-func (c *UnisphereClient) GetSystemCapacity(fields []string) (*SystemCapacityInstances, error) {
-	joinedFields := strings.Join(fields, ",")
-	body, err := c.get("/api/types/systemCapacity/instances?compact=true&fields=" + joinedFields)
-	if err != nil {
-		return nil, err
-	}
-
-	var data SystemCapacityInstances
-	err = json.Unmarshal(body, &data)
-	if err != nil {
-		return nil, err
-	}
-
-	return &data, nil
+func (c *UnisphereClient) GetSystemCapacityInstances(fields []string, filter *Filter) (*SystemCapacityInstances, error) {
+	return getInstances[SystemCapacityContent](c, "systemCapacity", fields, filter)
 }
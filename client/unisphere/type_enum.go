@@ -43,3 +43,58 @@ type Health struct {
 	ResolutionIds  []string   `json:"resolutionIds"`
 	Resolutions    []string   `json:"resolutions"`
 }
+
+type SeverityEnum int
+
+const (
+	SeverityEnumEmergency SeverityEnum = 0
+	SeverityEnumAlert     SeverityEnum = 1
+	SeverityEnumCritical  SeverityEnum = 2
+	SeverityEnumError     SeverityEnum = 3
+	SeverityEnumWarning   SeverityEnum = 4
+	SeverityEnumNotice    SeverityEnum = 5
+	SeverityEnumInfo      SeverityEnum = 6
+	SeverityEnumDebug     SeverityEnum = 7
+	SeverityEnumOk        SeverityEnum = 8
+)
+
+func (s SeverityEnum) String() string {
+	switch s {
+	case SeverityEnumEmergency:
+		return "Emergency"
+	case SeverityEnumAlert:
+		return "Alert"
+	case SeverityEnumCritical:
+		return "Critical"
+	case SeverityEnumError:
+		return "Error"
+	case SeverityEnumWarning:
+		return "Warning"
+	case SeverityEnumNotice:
+		return "Notice"
+	case SeverityEnumInfo:
+		return "Info"
+	case SeverityEnumDebug:
+		return "Debug"
+	case SeverityEnumOk:
+		return "Ok"
+	default:
+		return "Unknown"
+	}
+}
+
+// Size
+// 용량 (bytes)
+type Size int64
+
+func (s Size) ToMiB() float64 {
+	return float64(s) / 1024 / 1024
+}
+
+// ResourceRef
+// 다른 resource 에 대한 참조 (ex. pool, nasServer)
+// fields 에 "pool.name" 과 같이 요청하면 Name 도 함께 조회됩니다.
+type ResourceRef struct {
+	Id   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
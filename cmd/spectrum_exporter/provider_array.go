@@ -0,0 +1,226 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Arinashin3/ari-agent/utils/convert"
+	"github.com/Arinashin3/ari-agent/utils/provider"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkMetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+type arrayProvider struct {
+	moduleName    string
+	interval      time.Duration
+	meterProvider *sdkMetric.MeterProvider
+	clientDesc    *ClientDesc
+}
+
+func init() {
+	moduleName := "array"
+	registProvider(moduleName, &arrayProvider{moduleName: moduleName})
+}
+
+func (pv *arrayProvider) IsDefaultEnabled() bool {
+	return true
+}
+
+func (pv *arrayProvider) NewProvider(moduleName string, cl *ClientDesc) Provider {
+	pvConf := cfg.Providers.Array
+	enabled := pvConf.GetEnabled(pv.IsDefaultEnabled())
+	interval := pvConf.GetInterval()
+
+	if !enabled {
+		return nil
+	}
+	if MetricExporter == nil {
+		return nil
+	}
+	mp := provider.NewMeterProvider(serviceName, interval, MetricExporter)
+	return &arrayProvider{
+		moduleName:    moduleName,
+		interval:      interval,
+		meterProvider: mp,
+		clientDesc:    cl,
+	}
+}
+
+// MDisk Status 값: 0: online, 1: degraded, 2: offline, 3: excluded, -1: unknown
+// RAID Status 값: 0: online, 1: degraded, 2: offline, 3: syncing, 4: initting, 5: expanding, -1: unknown
+var ArrayMetricDescs = []*provider.MetricDescriptor{
+	{
+		Key:      "mdisk_status",
+		Name:     "spectrum_mdisk_status",
+		Desc:     "Status of the mdisk",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "mdisk_capacity",
+		Name:     "spectrum_mdisk_capacity",
+		Desc:     "Capacity of the mdisk",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "raid_status",
+		Name:     "spectrum_array_raid_status",
+		Desc:     "RAID status of the array",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "raid_level",
+		Name:     "spectrum_array_raid_level",
+		Desc:     "RAID level of the array",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "redundancy",
+		Name:     "spectrum_array_redundancy",
+		Desc:     "Number of member drives that can fail before the array goes offline",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "sync_progress",
+		Name:     "spectrum_array_sync_progress",
+		Desc:     "Synchronization progress of the array",
+		Unit:     "%",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "sync_estimated_completion",
+		Name:     "spectrum_array_sync_estimated_completion",
+		Desc:     "Estimated completion time of the array synchronization",
+		Unit:     "timestamp",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "rebuild_progress",
+		Name:     "spectrum_array_rebuild_progress",
+		Desc:     "Progress of the background task (rebuild, exchange, copyback) on the array member",
+		Unit:     "%",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "rebuild_estimated_completion",
+		Name:     "spectrum_array_rebuild_estimated_completion",
+		Desc:     "Estimated completion time of the background task on the array member",
+		Unit:     "timestamp",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "rebuild_remaining",
+		Name:     "spectrum_array_rebuild_remaining",
+		Desc:     "Time remaining until the background task on the array member completes",
+		Unit:     "s",
+		TypeName: "gauge",
+	},
+}
+
+func (pv *arrayProvider) Run() {
+	logger.Info("Starting provider", "endpoint", pv.clientDesc.endpoint, "provider", pv.moduleName)
+	meter := pv.meterProvider.Meter(pv.moduleName)
+
+	// Register Metrics...
+	var observableMap map[string]metric.Float64Observable
+	observableMap = provider.CreateMapMetricDescriptor(meter, ArrayMetricDescs, logger)
+
+	// Register Metrics for Observables...
+	var observableArray []metric.Observable
+	for _, observable := range observableMap {
+		observableArray = append(observableArray, observable)
+	}
+
+	// ==============================
+	// Callback
+	// ==============================
+	meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+		// Client Attributes
+		clientAttrs := metric.WithAttributes(pv.clientDesc.hostLabels...)
+
+		// Request Data
+		c := pv.clientDesc.client
+		data := c.PostLsMdisk()
+		if data == nil {
+			logger.Warn("data is nil", "provider", pv.moduleName, "endpoint", pv.clientDesc.endpoint)
+			return nil
+		}
+
+		// MDisks
+		poolNames := make(map[string]string)
+		for _, v := range data {
+			poolNames[v.Id] = v.MdiskGrpName
+			mdiskAttrs := metric.WithAttributes(
+				attribute.String("mdisk.name", v.Name),
+				attribute.String("mdisk.id", v.Id),
+				attribute.String("mdisk.mode", v.Mode),
+				attribute.String("mdisk.tier", v.Tier),
+				attribute.String("pool.name", v.MdiskGrpName),
+				attribute.String("controller.name", v.ControllerName),
+			)
+			observer.ObserveFloat64(observableMap["mdisk_status"], v.Status.Float64(), clientAttrs, mdiskAttrs)
+			observer.ObserveFloat64(observableMap["mdisk_capacity"], convert.ParseUnitConvert(v.Capacity, "mb"), clientAttrs, mdiskAttrs)
+		}
+
+		// Arrays
+		for _, v := range c.PostLsArray() {
+			arrayAttrs := metric.WithAttributes(
+				attribute.String("mdisk.name", v.MdiskName),
+				attribute.String("mdisk.id", v.MdiskId),
+				attribute.String("pool.name", v.MdiskGrpName),
+				attribute.String("raid.level", v.RaidLevel),
+				attribute.String("array.distributed", v.Distributed),
+			)
+			observer.ObserveFloat64(observableMap["raid_status"], v.RaidStatus.Float64(), clientAttrs, arrayAttrs)
+			// raid0, raid1, raid5, raid6, raid10 => 0, 1, 5, 6, 10
+			if level, err := strconv.ParseFloat(strings.TrimPrefix(v.RaidLevel, "raid"), 64); err == nil {
+				observer.ObserveFloat64(observableMap["raid_level"], level, clientAttrs, arrayAttrs)
+			}
+			observer.ObserveFloat64(observableMap["redundancy"], v.Redundancy.Float64(), clientAttrs, arrayAttrs)
+		}
+
+		// 시간 값은 시스템 시간대 기준
+		loc := c.Location()
+
+		// Array Synchronization
+		for _, v := range c.PostLsArraySyncProgress() {
+			syncAttrs := metric.WithAttributes(
+				attribute.String("mdisk.name", v.MdiskName),
+				attribute.String("mdisk.id", v.MdiskId),
+				attribute.String("pool.name", poolNames[v.MdiskId]),
+			)
+			observer.ObserveFloat64(observableMap["sync_progress"], v.Progress.Float64(), clientAttrs, syncAttrs)
+			if completion, err := v.EstimatedCompletion(loc); err == nil {
+				observer.ObserveFloat64(observableMap["sync_estimated_completion"], float64(completion.Unix()), clientAttrs, syncAttrs)
+			}
+		}
+
+		// Array Member Rebuild
+		now := time.Now()
+		for _, v := range c.PostLsArrayMemberProgress() {
+			memberAttrs := metric.WithAttributes(
+				attribute.String("mdisk.name", v.MdiskName),
+				attribute.String("mdisk.id", v.MdiskId),
+				attribute.String("pool.name", poolNames[v.MdiskId]),
+				attribute.String("member.id", v.MemberId),
+				attribute.String("drive.id", v.DriveId),
+				attribute.String("task", v.Task),
+			)
+			observer.ObserveFloat64(observableMap["rebuild_progress"], v.Progress.Float64(), clientAttrs, memberAttrs)
+			if completion, err := v.EstimatedCompletion(loc); err == nil {
+				observer.ObserveFloat64(observableMap["rebuild_estimated_completion"], float64(completion.Unix()), clientAttrs, memberAttrs)
+				observer.ObserveFloat64(observableMap["rebuild_remaining"], max(completion.Sub(now).Seconds(), 0), clientAttrs, memberAttrs)
+			}
+		}
+
+		return nil
+	}, observableArray...)
+
+}
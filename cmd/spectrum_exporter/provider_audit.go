@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/Arinashin3/ari-agent/client/spectrum"
+	"github.com/Arinashin3/ari-agent/utils/provider"
+	"go.opentelemetry.io/otel/log"
+	sdkLog "go.opentelemetry.io/otel/sdk/log"
+)
+
+func init() {
+	moduleName := "audit"
+	registProvider(moduleName, &auditProvider{moduleName: moduleName})
+}
+
+func (pv *auditProvider) IsDefaultEnabled() bool {
+	return false
+}
+
+func (pv *auditProvider) NewProvider(moduleName string, cl *ClientDesc) Provider {
+	pvConf := cfg.Providers.Audit
+	enabled := pvConf.GetEnabled(pv.IsDefaultEnabled())
+	interval := pvConf.GetInterval()
+	//
+	if !enabled {
+		return nil
+	}
+	if LogExporter == nil {
+		return nil
+	}
+	lp := provider.NewLoggerProvider(serviceName, interval, LogExporter)
+	return &auditProvider{
+		moduleName:     moduleName,
+		interval:       interval,
+		loggerProvider: lp,
+		clientDesc:     cl,
+	}
+}
+
+type auditProvider struct {
+	moduleName     string
+	interval       time.Duration
+	loggerProvider *sdkLog.LoggerProvider
+	clientDesc     *ClientDesc
+	// 마지막으로 전송한 audit_seq_no
+	cursor int
+}
+
+// 이전 조회 이후의 로그만 가져오기 위해, 최근 auditBatchSize 개만 조회 (부족하면 전체 조회)
+const auditBatchSize = 100
+
+func (pv *auditProvider) Run() {
+	logger.Info("Starting provider", "endpoint", pv.clientDesc.endpoint, "provider", pv.moduleName)
+	ctx := context.Background()
+	ctime := time.Now().Add(-1 * time.Hour)
+	lastPolled := ctime
+	cl := pv.clientDesc.client
+	lp := pv.loggerProvider
+
+	for {
+		pvlogger := lp.Logger(pv.moduleName, log.WithInstrumentationAttributes(pv.clientDesc.hostLabels...))
+		polled := time.Now()
+
+		// -first 는 최근 N 개의 로그를 조회
+		var data []*spectrum.CatAuditLogInst
+		if pv.cursor > 0 {
+			data = cl.PostCatAuditLog(auditBatchSize)
+			if len(data) >= auditBatchSize && data[0].SeqNo() > pv.cursor+1 {
+				data = cl.PostCatAuditLog(0)
+			}
+		} else {
+			data = cl.PostCatAuditLog(0)
+		}
+		loc := cl.Location()
+
+		if data == nil {
+			time.Sleep(pv.interval)
+			continue
+		}
+
+		// 노드 failover 등으로 audit_seq_no 가 초기화된 경우, 마지막 조회 이후의 로그부터 다시 전송
+		if pv.cursor > 0 && len(data) > 0 && data[len(data)-1].SeqNo() < pv.cursor {
+			logger.Warn("Audit log sequence number was reset", "provider", pv.moduleName, "endpoint", pv.clientDesc.endpoint, "cursor", pv.cursor, "newest", data[len(data)-1].SeqNo())
+			pv.cursor = 0
+			ctime = lastPolled
+		}
+
+		// cursor 이후의 로그가 메모리에서 덤프 파일로 이동된 경우
+		if pv.cursor > 0 && len(data) > 0 && data[0].SeqNo() > pv.cursor+1 {
+			var dumpFile string
+			dumps := cl.PostLsAuditLogDumps()
+			if len(dumps) > 0 {
+				dumpFile = dumps[len(dumps)-1].AuditlogFilename
+			}
+			logger.Warn("Some audit log entries were moved to dump files before being sent", "provider", pv.moduleName, "endpoint", pv.clientDesc.endpoint, "from", pv.cursor+1, "to", data[0].SeqNo()-1, "dump", dumpFile)
+		}
+
+		for _, entry := range data {
+			seq := entry.SeqNo()
+			if seq <= pv.cursor {
+				continue
+			}
+			auditTime, err := entry.Time(loc)
+			if err != nil {
+				logger.Error("Error parsing timestamp", "err", err)
+			}
+			// 최초 실행 시에는 최근 1시간 이내의 로그만 전송
+			if pv.cursor == 0 && auditTime.Before(ctime) {
+				continue
+			}
+
+			record := log.Record{}
+			record.SetTimestamp(auditTime)
+			record.SetObservedTimestamp(auditTime)
+			record.AddAttributes(
+				log.String("level", "INFO"),
+				log.String("audit.seq", entry.AuditSeqNo),
+				log.String("user", entry.ClusterUser),
+				log.String("source.ip", entry.SshIpAddress),
+				log.String("result", entry.Result),
+				log.String("object.id", entry.ResObjId),
+			)
+			record.SetBody(log.StringValue(entry.ActionCmd))
+
+			pvlogger.Emit(ctx, record)
+		}
+		if len(data) > 0 {
+			if seq := data[len(data)-1].SeqNo(); seq > pv.cursor {
+				pv.cursor = seq
+			}
+		}
+		lastPolled = polled
+		time.Sleep(pv.interval)
+	}
+}
@@ -82,6 +82,20 @@ var FlashcopyMetricDescs = []*provider.MetricDescriptor{
 		Unit:     "timestamp",
 		TypeName: "gauge",
 	},
+	{
+		Key:      "group_status",
+		Name:     "spectrum_flashcopy_group_status",
+		Desc:     "Status of the flashcopy consistency group",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "group_start_time",
+		Name:     "spectrum_flashcopy_group_start",
+		Desc:     "Time the flashcopy consistency group was last started",
+		Unit:     "timestamp",
+		TypeName: "gauge",
+	},
 }
 
 func (pv *flashcopyProvider) Run() {
@@ -108,6 +122,8 @@ func (pv *flashcopyProvider) Run() {
 		// Request Data
 		c := pv.clientDesc.client
 		data := c.PostLsFcMap()
+		// 시간 값은 시스템 시간대 기준
+		loc := c.Location()
 		if data == nil {
 			logger.Warn("data is nil", "provider", pv.moduleName, "endpoint", pv.clientDesc.endpoint)
 			return nil
@@ -122,10 +138,21 @@ func (pv *flashcopyProvider) Run() {
 			observer.ObserveFloat64(observableMap["progress"], v.Progress.Float64(), clientAttrs, fcAttrs)
 			observer.ObserveFloat64(observableMap["copy_rate"], v.CopyRate.Float64(), clientAttrs, fcAttrs)
 			observer.ObserveFloat64(observableMap["clean_progress"], v.CleanProgress.Float64(), clientAttrs, fcAttrs)
-			observer.ObserveFloat64(observableMap["start_time"], v.StartTime.Time2Float64(), clientAttrs, fcAttrs)
+			observer.ObserveFloat64(observableMap["start_time"], v.StartTime.Time2Float64In(loc), clientAttrs, fcAttrs)
 
 		}
 
+		// Consistency Groups
+		for _, v := range c.PostLsFcConsistGrp() {
+			groupAttrs := metric.WithAttributes(
+				attribute.String("fc.group", v.Name),
+			)
+			observer.ObserveFloat64(observableMap["group_status"], v.Status.Float64(), clientAttrs, groupAttrs)
+			if v.StartTime != "" {
+				observer.ObserveFloat64(observableMap["group_start_time"], v.StartTime.Time2Float64In(loc), clientAttrs, groupAttrs)
+			}
+		}
+
 		// Info Attributes
 
 		return nil
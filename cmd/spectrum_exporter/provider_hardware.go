@@ -0,0 +1,260 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/Arinashin3/ari-agent/utils/provider"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkMetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+type hardwareProvider struct {
+	moduleName    string
+	interval      time.Duration
+	meterProvider *sdkMetric.MeterProvider
+	clientDesc    *ClientDesc
+	// 상세 조회로 가져온 FRU 정보 (fruRefreshInterval 마다 갱신)
+	fruInfos   map[string]*fruInfo
+	fruUpdated time.Time
+}
+
+type fruInfo struct {
+	partNumber string
+	identity   string
+}
+
+// FRU 정보는 부품 교체 시에만 바뀌므로 자주 조회하지 않음
+const fruRefreshInterval = time.Hour
+
+func init() {
+	moduleName := "hardware"
+	registProvider(moduleName, &hardwareProvider{moduleName: moduleName})
+}
+
+func (pv *hardwareProvider) IsDefaultEnabled() bool {
+	return true
+}
+
+func (pv *hardwareProvider) NewProvider(moduleName string, cl *ClientDesc) Provider {
+	pvConf := cfg.Providers.Hardware
+	enabled := pvConf.GetEnabled(pv.IsDefaultEnabled())
+	interval := pvConf.GetInterval()
+
+	if !enabled {
+		return nil
+	}
+	if MetricExporter == nil {
+		return nil
+	}
+	mp := provider.NewMeterProvider(serviceName, interval, MetricExporter)
+	return &hardwareProvider{
+		moduleName:    moduleName,
+		interval:      interval,
+		meterProvider: mp,
+		clientDesc:    cl,
+	}
+}
+
+// Status 값: 0: online, 1: degraded, 2: offline, 3: service, 4: flushing, 5: pending, 6: adding, 7: deleting, -1: unknown
+var HardwareMetricDescs = []*provider.MetricDescriptor{
+	{
+		Key:      "node_status",
+		Name:     "spectrum_hardware_node_status",
+		Desc:     "Status of the node canister",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "enclosure_status",
+		Name:     "spectrum_hardware_enclosure_status",
+		Desc:     "Status of the enclosure",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "battery_status",
+		Name:     "spectrum_hardware_battery_status",
+		Desc:     "Status of the enclosure battery",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "battery_charged",
+		Name:     "spectrum_hardware_battery_charged",
+		Desc:     "Charge level of the enclosure battery",
+		Unit:     "%",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "battery_end_of_life",
+		Name:     "spectrum_hardware_battery_end_of_life_warning",
+		Desc:     "Whether the enclosure battery is reaching the end of its life",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "psu_status",
+		Name:     "spectrum_hardware_psu_status",
+		Desc:     "Status of the enclosure power supply unit",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "drive_status",
+		Name:     "spectrum_hardware_drive_status",
+		Desc:     "Status of the drive",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "drive_use",
+		Name:     "spectrum_hardware_drive_use",
+		Desc:     "Use of the drive (0: unused, 1: candidate, 2: spare, 3: member, 4: failed)",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+}
+
+func (pv *hardwareProvider) Run() {
+	logger.Info("Starting provider", "endpoint", pv.clientDesc.endpoint, "provider", pv.moduleName)
+	meter := pv.meterProvider.Meter(pv.moduleName)
+
+	// Register Metrics...
+	var observableMap map[string]metric.Float64Observable
+	observableMap = provider.CreateMapMetricDescriptor(meter, HardwareMetricDescs, logger)
+
+	// Register Metrics for Observables...
+	var observableArray []metric.Observable
+	for _, observable := range observableMap {
+		observableArray = append(observableArray, observable)
+	}
+
+	// ==============================
+	// Callback
+	// ==============================
+	meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+		// Client Attributes
+		clientAttrs := metric.WithAttributes(pv.clientDesc.hostLabels...)
+
+		// Request Data (Enclosure)
+		c := pv.clientDesc.client
+		if pv.fruInfos == nil || time.Since(pv.fruUpdated) > fruRefreshInterval {
+			pv.fruInfos = make(map[string]*fruInfo)
+			pv.fruUpdated = time.Now()
+		}
+		enclosures := c.PostLsEnclosure()
+		if enclosures == nil {
+			logger.Warn("data is nil", "provider", pv.moduleName, "endpoint", pv.clientDesc.endpoint)
+			return nil
+		}
+		enclosureSerials := make(map[string]string)
+		for _, v := range enclosures {
+			enclosureSerials[v.Id] = v.SerialNumber
+			enclosureAttrs := metric.WithAttributes(
+				attribute.String("enclosure.id", v.Id),
+				attribute.String("enclosure.serial", v.SerialNumber),
+				attribute.String("enclosure.type", v.Type),
+				attribute.String("enclosure.mtm", v.ProductMTM),
+			)
+			observer.ObserveFloat64(observableMap["enclosure_status"], v.Status.Float64(), clientAttrs, enclosureAttrs)
+		}
+
+		// Request Data (Node Canister)
+		for _, v := range c.PostLsNodeCanister() {
+			nodeAttrs := metric.WithAttributes(
+				attribute.String("node.id", v.Id),
+				attribute.String("node.name", v.Name),
+				attribute.String("iogroup.name", v.IOGroupName),
+				attribute.String("enclosure.id", v.EnclosureId),
+				attribute.String("enclosure.serial", v.EnclosureSerialNumber),
+				attribute.String("canister.id", v.CanisterId),
+			)
+			observer.ObserveFloat64(observableMap["node_status"], v.Status.Float64(), clientAttrs, nodeAttrs)
+		}
+
+		// Request Data (Battery)
+		for _, v := range c.PostLsEnclosureBattery() {
+			fru := pv.getFruInfo("battery/"+v.EnclosureId+"/"+v.BatteryId, func() *fruInfo {
+				detail := c.PostLsEnclosureBatteryDetail(v.EnclosureId, v.BatteryId)
+				if detail == nil {
+					return nil
+				}
+				return &fruInfo{partNumber: detail.FRUPartNumber, identity: detail.FRUIdentity}
+			})
+			batteryAttrs := metric.WithAttributes(
+				attribute.String("enclosure.id", v.EnclosureId),
+				attribute.String("enclosure.serial", enclosureSerials[v.EnclosureId]),
+				attribute.String("battery.id", v.BatteryId),
+				attribute.String("canister.id", v.CanisterId),
+				attribute.String("slot.id", v.BatterySlot),
+				attribute.String("fru.part_number", fru.partNumber),
+				attribute.String("fru.identity", fru.identity),
+			)
+			observer.ObserveFloat64(observableMap["battery_status"], v.Status.Float64(), clientAttrs, batteryAttrs)
+			observer.ObserveFloat64(observableMap["battery_charged"], v.PercentCharged.Float64(), clientAttrs, batteryAttrs)
+			observer.ObserveFloat64(observableMap["battery_end_of_life"], v.EndOfLifeWarning.Float64(), clientAttrs, batteryAttrs)
+		}
+
+		// Request Data (PSU)
+		for _, v := range c.PostLsEnclosurePSU() {
+			fru := pv.getFruInfo("psu/"+v.EnclosureId+"/"+v.PSUId, func() *fruInfo {
+				detail := c.PostLsEnclosurePSUDetail(v.EnclosureId, v.PSUId)
+				if detail == nil {
+					return nil
+				}
+				return &fruInfo{partNumber: detail.FRUPartNumber, identity: detail.FRUIdentity}
+			})
+			psuAttrs := metric.WithAttributes(
+				attribute.String("enclosure.id", v.EnclosureId),
+				attribute.String("enclosure.serial", enclosureSerials[v.EnclosureId]),
+				attribute.String("psu.id", v.PSUId),
+				attribute.String("fru.part_number", fru.partNumber),
+				attribute.String("fru.identity", fru.identity),
+			)
+			observer.ObserveFloat64(observableMap["psu_status"], v.Status.Float64(), clientAttrs, psuAttrs)
+		}
+
+		// Request Data (Drive)
+		for _, v := range c.PostLsDrive() {
+			fru := pv.getFruInfo("drive/"+v.Id, func() *fruInfo {
+				detail := c.PostLsDriveDetail(v.Id)
+				if detail == nil {
+					return nil
+				}
+				return &fruInfo{partNumber: detail.FRUPartNumber, identity: detail.FRUIdentity}
+			})
+			driveAttrs := metric.WithAttributes(
+				attribute.String("drive.id", v.Id),
+				attribute.String("drive.type", v.TechType),
+				attribute.String("enclosure.id", v.EnclosureId),
+				attribute.String("enclosure.serial", enclosureSerials[v.EnclosureId]),
+				attribute.String("slot.id", v.SlotId),
+				attribute.String("mdisk.name", v.MdiskName),
+				attribute.String("fru.part_number", fru.partNumber),
+				attribute.String("fru.identity", fru.identity),
+			)
+			observer.ObserveFloat64(observableMap["drive_status"], v.Status.Float64(), clientAttrs, driveAttrs)
+			observer.ObserveFloat64(observableMap["drive_use"], v.Use.Float64(), clientAttrs, driveAttrs)
+		}
+
+		return nil
+	}, observableArray...)
+
+}
+
+// getFruInfo
+// 캐시에 없으면 fetch 로 상세 조회합니다.
+// 조회에 실패한 경우 빈 값을 반환하고 다음 수집 시 다시 조회합니다.
+func (pv *hardwareProvider) getFruInfo(key string, fetch func() *fruInfo) *fruInfo {
+	if fru, ok := pv.fruInfos[key]; ok {
+		return fru
+	}
+	fru := fetch()
+	if fru == nil {
+		return &fruInfo{}
+	}
+	pv.fruInfos[key] = fru
+	return fru
+}
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/Arinashin3/ari-agent/utils/convert"
+	"github.com/Arinashin3/ari-agent/utils/provider"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkMetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+type hostProvider struct {
+	moduleName    string
+	interval      time.Duration
+	meterProvider *sdkMetric.MeterProvider
+	clientDesc    *ClientDesc
+}
+
+func init() {
+	moduleName := "host"
+	registProvider(moduleName, &hostProvider{moduleName: moduleName})
+}
+
+func (pv *hostProvider) IsDefaultEnabled() bool {
+	return false
+}
+
+func (pv *hostProvider) NewProvider(moduleName string, cl *ClientDesc) Provider {
+	pvConf := cfg.Providers.Host
+	enabled := pvConf.GetEnabled(pv.IsDefaultEnabled())
+	interval := pvConf.GetInterval()
+
+	if !enabled {
+		return nil
+	}
+	if MetricExporter == nil {
+		return nil
+	}
+	mp := provider.NewMeterProvider(serviceName, interval, MetricExporter)
+	return &hostProvider{
+		moduleName:    moduleName,
+		interval:      interval,
+		meterProvider: mp,
+		clientDesc:    cl,
+	}
+}
+
+// Status 값: 0: online, 1: degraded, 2: offline, 3: excluded, -1: unknown
+var HostMetricDescs = []*provider.MetricDescriptor{
+	{
+		Key:      "status",
+		Name:     "spectrum_host_status",
+		Desc:     "Status of the host",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "port_count",
+		Name:     "spectrum_host_port_count",
+		Desc:     "Number of ports defined for the host",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "mapped_volumes",
+		Name:     "spectrum_host_mapped_volumes",
+		Desc:     "Number of volumes mapped to the host",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "mapped_capacity",
+		Name:     "spectrum_host_mapped_capacity",
+		Desc:     "Total capacity of volumes mapped to the host",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "cluster_status",
+		Name:     "spectrum_hostcluster_status",
+		Desc:     "Status of the host cluster",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "cluster_host_count",
+		Name:     "spectrum_hostcluster_host_count",
+		Desc:     "Number of hosts in the host cluster",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "cluster_mapping_count",
+		Name:     "spectrum_hostcluster_mapping_count",
+		Desc:     "Number of shared volume mappings of the host cluster",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+}
+
+func (pv *hostProvider) Run() {
+	logger.Info("Starting provider", "endpoint", pv.clientDesc.endpoint, "provider", pv.moduleName)
+	meter := pv.meterProvider.Meter(pv.moduleName)
+
+	// Register Metrics...
+	var observableMap map[string]metric.Float64Observable
+	observableMap = provider.CreateMapMetricDescriptor(meter, HostMetricDescs, logger)
+
+	// Register Metrics for Observables...
+	var observableArray []metric.Observable
+	for _, observable := range observableMap {
+		observableArray = append(observableArray, observable)
+	}
+
+	// ==============================
+	// Callback
+	// ==============================
+	meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+		// Client Attributes
+		clientAttrs := metric.WithAttributes(pv.clientDesc.hostLabels...)
+
+		// Request Data
+		c := pv.clientDesc.client
+		data := c.PostLsHost()
+		if data == nil {
+			logger.Warn("data is nil", "provider", pv.moduleName, "endpoint", pv.clientDesc.endpoint)
+			return nil
+		}
+
+		// Volume Capacity
+		// 조회에 실패한 경우 0 으로 보이지 않도록 mapped_capacity 는 전송하지 않음
+		vdisks := c.PostLsVdisk()
+		if vdisks == nil {
+			logger.Warn("vdisk data is nil, skip mapped capacity", "provider", pv.moduleName, "endpoint", pv.clientDesc.endpoint)
+		}
+		vdiskCapacity := make(map[string]float64)
+		for _, v := range vdisks {
+			vdiskCapacity[v.Id] = convert.ParseUnitConvert(v.Capacity, "mb")
+		}
+
+		// Host Mappings
+		mappedVolumes := make(map[string]float64)
+		mappedCapacity := make(map[string]float64)
+		hostMaps := c.PostLsHostVdiskMap()
+		if hostMaps == nil {
+			logger.Warn("host vdisk map data is nil, skip mappings", "provider", pv.moduleName, "endpoint", pv.clientDesc.endpoint)
+		}
+		for _, v := range hostMaps {
+			mappedVolumes[v.Id]++
+			mappedCapacity[v.Id] += vdiskCapacity[v.VdiskId]
+		}
+
+		for _, v := range data {
+			hostAttrs := metric.WithAttributes(
+				attribute.String("svc_host.name", v.Name),
+				attribute.String("svc_host.id", v.Id),
+				attribute.String("svc_host.cluster", v.HostClusterName),
+				attribute.String("svc_host.protocol", v.Protocol),
+			)
+			observer.ObserveFloat64(observableMap["status"], v.Status.Float64(), clientAttrs, hostAttrs)
+			observer.ObserveFloat64(observableMap["port_count"], v.PortCount.Float64(), clientAttrs, hostAttrs)
+			if hostMaps == nil {
+				continue
+			}
+			observer.ObserveFloat64(observableMap["mapped_volumes"], mappedVolumes[v.Id], clientAttrs, hostAttrs)
+			if vdisks != nil {
+				observer.ObserveFloat64(observableMap["mapped_capacity"], mappedCapacity[v.Id], clientAttrs, hostAttrs)
+			}
+		}
+
+		// Host Clusters
+		for _, v := range c.PostLsHostCluster() {
+			clusterAttrs := metric.WithAttributes(
+				attribute.String("svc_host.cluster", v.Name),
+			)
+			observer.ObserveFloat64(observableMap["cluster_status"], v.Status.Float64(), clientAttrs, clusterAttrs)
+			observer.ObserveFloat64(observableMap["cluster_host_count"], v.HostCount.Float64(), clientAttrs, clusterAttrs)
+			observer.ObserveFloat64(observableMap["cluster_mapping_count"], v.MappingCount.Float64(), clientAttrs, clusterAttrs)
+		}
+
+		return nil
+	}, observableArray...)
+
+}
@@ -0,0 +1,360 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/Arinashin3/ari-agent/client/spectrum"
+	"github.com/Arinashin3/ari-agent/utils/convert"
+	"github.com/Arinashin3/ari-agent/utils/provider"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkMetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+type iostatsProvider struct {
+	moduleName    string
+	interval      time.Duration
+	meterProvider *sdkMetric.MeterProvider
+	clientDesc    *ClientDesc
+
+	// key: node name + iostats type
+	lastFiles   map[string]string
+	lastSamples map[string]*spectrum.IOStats
+	lastRates   map[string]*spectrum.IOStatsRate
+	lastUpdated map[string]time.Time
+}
+
+// 새 파일이 (수집 주기, 샘플 간격 중 큰 값) x iostatsStaleFactor 동안 없으면 rate 를 더 이상 전송하지 않음
+const iostatsStaleFactor = 3
+
+func init() {
+	moduleName := "iostats"
+	registProvider(moduleName, &iostatsProvider{moduleName: moduleName})
+}
+
+func (pv *iostatsProvider) IsDefaultEnabled() bool {
+	return false
+}
+
+func (pv *iostatsProvider) NewProvider(moduleName string, cl *ClientDesc) Provider {
+	pvConf := cfg.Providers.Iostats
+	enabled := pvConf.GetEnabled(pv.IsDefaultEnabled())
+	interval := pvConf.GetInterval()
+
+	if !enabled {
+		return nil
+	}
+	if MetricExporter == nil {
+		return nil
+	}
+	mp := provider.NewMeterProvider(serviceName, interval, MetricExporter)
+	return &iostatsProvider{
+		moduleName:    moduleName,
+		interval:      interval,
+		meterProvider: mp,
+		clientDesc:    cl,
+		lastFiles:     make(map[string]string),
+		lastSamples:   make(map[string]*spectrum.IOStats),
+		lastRates:     make(map[string]*spectrum.IOStatsRate),
+		lastUpdated:   make(map[string]time.Time),
+	}
+}
+
+// iostatsDiskMetricDescs
+// volume, mdisk, drive 는 동일한 형식의 카운터를 가지므로 같은 descriptor 를 사용합니다.
+func iostatsDiskMetricDescs(entity string) []*provider.MetricDescriptor {
+	return []*provider.MetricDescriptor{
+		{
+			Key:      entity + "_read_iops",
+			Name:     "spectrum_iostats_" + entity + "_read_iops",
+			Desc:     "Read operations per second of the " + entity,
+			Unit:     "iops",
+			TypeName: "gauge",
+		},
+		{
+			Key:      entity + "_write_iops",
+			Name:     "spectrum_iostats_" + entity + "_write_iops",
+			Desc:     "Write operations per second of the " + entity,
+			Unit:     "iops",
+			TypeName: "gauge",
+		},
+		{
+			Key:      entity + "_read_throughput",
+			Name:     "spectrum_iostats_" + entity + "_read_throughput",
+			Desc:     "Read throughput of the " + entity,
+			Unit:     "mbps",
+			TypeName: "gauge",
+		},
+		{
+			Key:      entity + "_write_throughput",
+			Name:     "spectrum_iostats_" + entity + "_write_throughput",
+			Desc:     "Write throughput of the " + entity,
+			Unit:     "mbps",
+			TypeName: "gauge",
+		},
+		{
+			Key:      entity + "_read_latency",
+			Name:     "spectrum_iostats_" + entity + "_read_latency",
+			Desc:     "Average read response time of the " + entity,
+			Unit:     "ms",
+			TypeName: "gauge",
+		},
+		{
+			Key:      entity + "_write_latency",
+			Name:     "spectrum_iostats_" + entity + "_write_latency",
+			Desc:     "Average write response time of the " + entity,
+			Unit:     "ms",
+			TypeName: "gauge",
+		},
+	}
+}
+
+var IostatsNodeMetricDescs = []*provider.MetricDescriptor{
+	{
+		Key:      "node_cpu",
+		Name:     "spectrum_iostats_node_cpu",
+		Desc:     "CPU utilization of the node",
+		Unit:     "%",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "port_tx_throughput",
+		Name:     "spectrum_iostats_port_tx_throughput",
+		Desc:     "Bytes transmitted to hosts per second through the port",
+		Unit:     "mbps",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "port_rx_throughput",
+		Name:     "spectrum_iostats_port_rx_throughput",
+		Desc:     "Bytes received from hosts per second through the port",
+		Unit:     "mbps",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "port_tx_iops",
+		Name:     "spectrum_iostats_port_tx_iops",
+		Desc:     "Exchanges transmitted to hosts per second through the port",
+		Unit:     "iops",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "port_rx_iops",
+		Name:     "spectrum_iostats_port_rx_iops",
+		Desc:     "Exchanges received from hosts per second through the port",
+		Unit:     "iops",
+		TypeName: "gauge",
+	},
+}
+
+func (pv *iostatsProvider) Run() {
+	logger.Info("Starting provider", "endpoint", pv.clientDesc.endpoint, "provider", pv.moduleName)
+	meter := pv.meterProvider.Meter(pv.moduleName)
+
+	// Register Metrics...
+	var metricDescs []*provider.MetricDescriptor
+	metricDescs = append(metricDescs, iostatsDiskMetricDescs("volume")...)
+	metricDescs = append(metricDescs, iostatsDiskMetricDescs("mdisk")...)
+	metricDescs = append(metricDescs, iostatsDiskMetricDescs("drive")...)
+	metricDescs = append(metricDescs, iostatsDiskMetricDescs("node")...)
+	metricDescs = append(metricDescs, IostatsNodeMetricDescs...)
+	var observableMap map[string]metric.Float64Observable
+	observableMap = provider.CreateMapMetricDescriptor(meter, metricDescs, logger)
+
+	// Register Metrics for Observables...
+	var observableArray []metric.Observable
+	for _, observable := range observableMap {
+		observableArray = append(observableArray, observable)
+	}
+
+	// ==============================
+	// Callback
+	// ==============================
+	meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+		// Client Attributes
+		clientAttrs := metric.WithAttributes(pv.clientDesc.hostLabels...)
+
+		// Request Data
+		c := pv.clientDesc.client
+		nodes := c.PostLsNodeCanister()
+		if nodes == nil {
+			logger.Warn("data is nil", "provider", pv.moduleName, "endpoint", pv.clientDesc.endpoint)
+			return nil
+		}
+		loc := c.Location()
+		onlineNodes := make(map[string]bool)
+		for _, node := range nodes {
+			if node.Status != spectrum.ComponentStatusEnumOnline {
+				continue
+			}
+			onlineNodes[node.Name] = true
+			pv.update(node, loc)
+		}
+
+		// online 이 아니거나 제거된 노드, 새 파일이 들어오지 않는 항목은 삭제
+		now := time.Now()
+		for key := range pv.lastFiles {
+			stale := !onlineNodes[key[:len(key)-2]]
+			if rate := pv.lastRates[key]; rate != nil && now.Sub(pv.lastUpdated[key]) > iostatsStaleFactor*max(pv.interval, rate.Interval) {
+				stale = true
+			}
+			if stale {
+				delete(pv.lastFiles, key)
+				delete(pv.lastSamples, key)
+				delete(pv.lastRates, key)
+				delete(pv.lastUpdated, key)
+			}
+		}
+
+		// Observe latest rates
+		for key, rate := range pv.lastRates {
+			nodeAttr := attribute.String("node.name", rate.NodeName)
+			var entity, labelKey string
+			switch spectrum.IOStatsType(key[len(key)-2:]) {
+			case spectrum.IOStatsTypeEnumVolume:
+				entity, labelKey = "volume", "volume.name"
+			case spectrum.IOStatsTypeEnumMdisk:
+				entity, labelKey = "mdisk", "mdisk.name"
+			case spectrum.IOStatsTypeEnumDrive:
+				entity, labelKey = "drive", "drive.id"
+			case spectrum.IOStatsTypeEnumNode:
+				if rate.CpuBusy >= 0 {
+					observer.ObserveFloat64(observableMap["node_cpu"], rate.CpuBusy, clientAttrs, metric.WithAttributes(nodeAttr))
+				}
+				for _, v := range rate.Ports {
+					portAttrs := metric.WithAttributes(
+						nodeAttr,
+						attribute.String("port.id", v.Id),
+						attribute.String("port.type", v.Type),
+						attribute.String("port.wwpn", v.Wwpn),
+					)
+					observer.ObserveFloat64(observableMap["port_tx_throughput"], convert.UnitConvert(v.TxThroughput, "mb"), clientAttrs, portAttrs)
+					observer.ObserveFloat64(observableMap["port_rx_throughput"], convert.UnitConvert(v.RxThroughput, "mb"), clientAttrs, portAttrs)
+					observer.ObserveFloat64(observableMap["port_tx_iops"], v.TxIOPS, clientAttrs, portAttrs)
+					observer.ObserveFloat64(observableMap["port_rx_iops"], v.RxIOPS, clientAttrs, portAttrs)
+				}
+				continue
+			}
+			for _, v := range rate.Disks {
+				diskAttrs := metric.WithAttributes(nodeAttr, attribute.String(labelKey, v.Id))
+				observer.ObserveFloat64(observableMap[entity+"_read_iops"], v.ReadIOPS, clientAttrs, diskAttrs)
+				observer.ObserveFloat64(observableMap[entity+"_write_iops"], v.WriteIOPS, clientAttrs, diskAttrs)
+				observer.ObserveFloat64(observableMap[entity+"_read_throughput"], convert.UnitConvert(v.ReadThroughput, "mb"), clientAttrs, diskAttrs)
+				observer.ObserveFloat64(observableMap[entity+"_write_throughput"], convert.UnitConvert(v.WriteThroughput, "mb"), clientAttrs, diskAttrs)
+				observer.ObserveFloat64(observableMap[entity+"_read_latency"], v.ReadLatency, clientAttrs, diskAttrs)
+				observer.ObserveFloat64(observableMap[entity+"_write_latency"], v.WriteLatency, clientAttrs, diskAttrs)
+			}
+
+			// Nn 파일에는 노드의 host IO 카운터가 없으므로, 노드가 처리한 volume IO (Nv) 의 합으로 계산
+			if entity == "volume" {
+				var node spectrum.IOStatsDiskRate
+				var readLatency, writeLatency float64
+				for _, v := range rate.Disks {
+					node.ReadIOPS += v.ReadIOPS
+					node.WriteIOPS += v.WriteIOPS
+					node.ReadThroughput += v.ReadThroughput
+					node.WriteThroughput += v.WriteThroughput
+					readLatency += v.ReadLatency * v.ReadIOPS
+					writeLatency += v.WriteLatency * v.WriteIOPS
+				}
+				if node.ReadIOPS > 0 {
+					node.ReadLatency = readLatency / node.ReadIOPS
+				}
+				if node.WriteIOPS > 0 {
+					node.WriteLatency = writeLatency / node.WriteIOPS
+				}
+				nodeAttrs := metric.WithAttributes(nodeAttr)
+				observer.ObserveFloat64(observableMap["node_read_iops"], node.ReadIOPS, clientAttrs, nodeAttrs)
+				observer.ObserveFloat64(observableMap["node_write_iops"], node.WriteIOPS, clientAttrs, nodeAttrs)
+				observer.ObserveFloat64(observableMap["node_read_throughput"], convert.UnitConvert(node.ReadThroughput, "mb"), clientAttrs, nodeAttrs)
+				observer.ObserveFloat64(observableMap["node_write_throughput"], convert.UnitConvert(node.WriteThroughput, "mb"), clientAttrs, nodeAttrs)
+				observer.ObserveFloat64(observableMap["node_read_latency"], node.ReadLatency, clientAttrs, nodeAttrs)
+				observer.ObserveFloat64(observableMap["node_write_latency"], node.WriteLatency, clientAttrs, nodeAttrs)
+			}
+		}
+
+		return nil
+	}, observableArray...)
+
+}
+
+// update
+// 노드의 최신 iostats 파일을 내려받아, 이전 샘플과의 차이로 rate 를 계산합니다.
+func (pv *iostatsProvider) update(node *spectrum.LsNodeCanisterInst, loc *time.Location) {
+	c := pv.clientDesc.client
+
+	// config node 가 아닌 경우, 파일을 config node 로 복사한 뒤 다운로드
+	nodeId := node.Id
+	if node.ConfigNode == "yes" {
+		nodeId = ""
+	}
+	dumps := c.PostLsDumps(spectrum.IOStatsPrefix, nodeId)
+	if dumps == nil {
+		logger.Warn("iostats dumps is nil", "provider", pv.moduleName, "endpoint", pv.clientDesc.endpoint, "node", node.Name)
+		return
+	}
+
+	// 파일명의 노드 부분 (panel name, 없으면 enclosure serial-canister id)
+	panelName := node.PanelName
+	if panelName == "" {
+		panelName = node.EnclosureSerialNumber + "-" + node.CanisterId
+	}
+
+	// 타입별 최신 파일
+	// config node 에는 다른 노드에서 복사한 파일도 있으므로 해당 노드의 파일만 사용
+	latestFiles := make(map[spectrum.IOStatsType]string)
+	latestTimes := make(map[spectrum.IOStatsType]time.Time)
+	for _, v := range dumps {
+		statsType, filePanel, fileTime, err := spectrum.ParseIOStatsFilename(v.Filename, loc)
+		if err != nil {
+			continue
+		}
+		if filePanel != panelName {
+			continue
+		}
+		if fileTime.After(latestTimes[statsType]) {
+			latestFiles[statsType] = v.Filename
+			latestTimes[statsType] = fileTime
+		}
+	}
+
+	for _, statsType := range spectrum.IOStatsTypes {
+		key := node.Name + string(statsType)
+		filename := latestFiles[statsType]
+		if filename == "" || filename == pv.lastFiles[key] {
+			continue
+		}
+
+		if nodeId != "" {
+			err := c.PostCpDumps(spectrum.IOStatsPrefix+"/"+filename, nodeId)
+			if err != nil {
+				logger.Error("Failed to copy iostats file", "provider", pv.moduleName, "file", filename, "error", err)
+				continue
+			}
+		}
+		body, err := c.PostDownload(spectrum.IOStatsPrefix, filename)
+		if err != nil {
+			logger.Error("Failed to download iostats file", "provider", pv.moduleName, "file", filename, "error", err)
+			continue
+		}
+		sample, err := spectrum.ParseIOStats(body, loc)
+		if err != nil {
+			logger.Error("Failed to parse iostats file", "provider", pv.moduleName, "file", filename, "error", err)
+			continue
+		}
+		sample.Id = node.Name
+
+		if prev := pv.lastSamples[key]; prev != nil {
+			rate, err := spectrum.CalcIOStatsRate(prev, sample)
+			if err != nil {
+				logger.Warn("Failed to calculate iostats rate", "provider", pv.moduleName, "file", filename, "error", err)
+			} else {
+				pv.lastRates[key] = rate
+			}
+		}
+		pv.lastFiles[key] = filename
+		pv.lastSamples[key] = sample
+		pv.lastUpdated[key] = time.Now()
+	}
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Arinashin3/ari-agent/utils/provider"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkMetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+type nodeStatsProvider struct {
+	moduleName    string
+	interval      time.Duration
+	meterProvider *sdkMetric.MeterProvider
+	clientDesc    *ClientDesc
+}
+
+func init() {
+	moduleName := "node_performance"
+	registProvider(moduleName, &nodeStatsProvider{moduleName: moduleName})
+}
+
+func (pv *nodeStatsProvider) IsDefaultEnabled() bool {
+	return true
+}
+
+func (pv *nodeStatsProvider) NewProvider(moduleName string, cl *ClientDesc) Provider {
+	pvConf := cfg.Providers.NodePerformance
+	enabled := pvConf.GetEnabled(pv.IsDefaultEnabled())
+	interval := pvConf.GetInterval()
+
+	if !enabled {
+		return nil
+	}
+	if MetricExporter == nil {
+		return nil
+	}
+	mp := provider.NewMeterProvider(serviceName, interval, MetricExporter)
+	return &nodeStatsProvider{
+		moduleName:    moduleName,
+		interval:      interval,
+		meterProvider: mp,
+		clientDesc:    cl,
+	}
+}
+
+// lsNodeStatsMetricDescs
+// lsSystemStatsMetricDescs 와 같은 이름 규칙(spectrum_system_stats_* => spectrum_node_stats_*)을 사용하고,
+// 목록에 없는 stat_name 은 이름과 접미사(_mb, _io, _ms, _pc)로 descriptor 를 생성합니다.
+func lsNodeStatsMetricDescs(statNames []string) []*provider.MetricDescriptor {
+	var mds []*provider.MetricDescriptor
+	known := make(map[string]bool)
+	for _, md := range lsSystemStatsMetricDescs {
+		known[md.Key] = true
+		mds = append(mds, &provider.MetricDescriptor{
+			Key:      md.Key,
+			Name:     strings.Replace(md.Name, "spectrum_system_stats_", "spectrum_node_stats_", 1),
+			Desc:     "Information about the node",
+			Unit:     md.Unit,
+			TypeName: md.TypeName,
+		})
+	}
+	for _, statName := range statNames {
+		if known[statName] {
+			continue
+		}
+		known[statName] = true
+
+		var unit string
+		switch {
+		case strings.HasSuffix(statName, "_mb"):
+			unit = "mbps"
+		case strings.HasSuffix(statName, "_io"):
+			unit = "iops"
+		case strings.HasSuffix(statName, "_ms"):
+			unit = "ms"
+		case strings.HasSuffix(statName, "_pc"):
+			unit = "%"
+		}
+		mds = append(mds, &provider.MetricDescriptor{
+			Key:      statName,
+			Name:     "spectrum_node_stats_" + statName,
+			Desc:     "Information about the node",
+			Unit:     unit,
+			TypeName: "gauge",
+		})
+	}
+	return mds
+}
+
+func (pv *nodeStatsProvider) Run() {
+	logger.Info("Starting provider", "endpoint", pv.clientDesc.endpoint, "provider", pv.moduleName)
+	meter := pv.meterProvider.Meter(pv.moduleName)
+	c := pv.clientDesc.client
+
+	// Get stat names from lsnodestats
+	var statNames []string
+	for _, v := range c.PostLsNodeStats() {
+		statNames = append(statNames, v.StatName)
+	}
+
+	// Register Metrics...
+	var observableMap map[string]metric.Float64Observable
+	observableMap = provider.CreateMapMetricDescriptor(meter, lsNodeStatsMetricDescs(statNames), logger)
+
+	// Register Metrics for Observables...
+	var observableArray []metric.Observable
+	for _, observable := range observableMap {
+		observableArray = append(observableArray, observable)
+	}
+
+	// ==============================
+	// Callback
+	// ==============================
+	meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+		// Client Attributes
+		clientAttrs := metric.WithAttributes(pv.clientDesc.hostLabels...)
+
+		// Request Data
+		data := c.PostLsNodeStats()
+		if data == nil {
+			logger.Warn("data is nil", "provider", pv.moduleName, "endpoint", pv.clientDesc.endpoint)
+			return nil
+		}
+		for _, v := range data {
+			f, _ := strconv.ParseFloat(v.StatCurrent, 64)
+			if observableMap[v.StatName] != nil {
+				nodeAttrs := metric.WithAttributes(
+					attribute.String("node.id", v.NodeId),
+					attribute.String("node.name", v.NodeName),
+				)
+				observer.ObserveFloat64(observableMap[v.StatName], f, clientAttrs, nodeAttrs)
+			}
+		}
+
+		return nil
+	}, observableArray...)
+
+}
@@ -0,0 +1,239 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/Arinashin3/ari-agent/utils/convert"
+	"github.com/Arinashin3/ari-agent/utils/provider"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkMetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+type poolProvider struct {
+	moduleName    string
+	interval      time.Duration
+	meterProvider *sdkMetric.MeterProvider
+	clientDesc    *ClientDesc
+}
+
+func init() {
+	moduleName := "pool"
+	registProvider(moduleName, &poolProvider{moduleName: moduleName})
+}
+
+func (pv *poolProvider) IsDefaultEnabled() bool {
+	return true
+}
+
+func (pv *poolProvider) NewProvider(moduleName string, cl *ClientDesc) Provider {
+	pvConf := cfg.Providers.Pool
+	enabled := pvConf.GetEnabled(pv.IsDefaultEnabled())
+	interval := pvConf.GetInterval()
+
+	if !enabled {
+		return nil
+	}
+	if MetricExporter == nil {
+		return nil
+	}
+	mp := provider.NewMeterProvider(serviceName, interval, MetricExporter)
+	return &poolProvider{
+		moduleName:    moduleName,
+		interval:      interval,
+		meterProvider: mp,
+		clientDesc:    cl,
+	}
+}
+
+var PoolMetricDescs = []*provider.MetricDescriptor{
+	{
+		Key:      "status",
+		Name:     "spectrum_pool_status",
+		Desc:     "Status of the pool (0: online, 1: degraded, 2: offline)",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "capacity",
+		Name:     "spectrum_pool_capacity",
+		Desc:     "Total capacity of the pool",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "free_capacity",
+		Name:     "spectrum_pool_free_capacity",
+		Desc:     "Free capacity of the pool",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "used_capacity",
+		Name:     "spectrum_pool_used_capacity",
+		Desc:     "Used capacity of the pool",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "real_capacity",
+		Name:     "spectrum_pool_real_capacity",
+		Desc:     "Real capacity allocated to volume copies in the pool",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "virtual_capacity",
+		Name:     "spectrum_pool_virtual_capacity",
+		Desc:     "Virtual capacity of all volume copies in the pool",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "overallocation",
+		Name:     "spectrum_pool_overallocation",
+		Desc:     "Virtual capacity as a percentage of the pool capacity",
+		Unit:     "%",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "warning",
+		Name:     "spectrum_pool_warning_threshold",
+		Desc:     "Used capacity warning threshold of the pool",
+		Unit:     "%",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "easy_tier_status",
+		Name:     "spectrum_pool_easy_tier_status",
+		Desc:     "Easy Tier status of the pool (0: inactive, 1: active, 2: measured, 3: balanced)",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "compression_saving",
+		Name:     "spectrum_pool_compression_saving",
+		Desc:     "Capacity saved by compression in the pool",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "deduplication_saving",
+		Name:     "spectrum_pool_deduplication_saving",
+		Desc:     "Capacity saved by deduplication in the pool",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "used_before_reduction",
+		Name:     "spectrum_pool_used_capacity_before_reduction",
+		Desc:     "Used capacity of the pool before data reduction",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "used_after_reduction",
+		Name:     "spectrum_pool_used_capacity_after_reduction",
+		Desc:     "Used capacity of the pool after data reduction",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "reclaimable_capacity",
+		Name:     "spectrum_pool_reclaimable_capacity",
+		Desc:     "Capacity that can be reclaimed by garbage collection in the pool",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "child_count",
+		Name:     "spectrum_pool_child_count",
+		Desc:     "Number of child pools in the pool",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "child_capacity",
+		Name:     "spectrum_pool_child_capacity",
+		Desc:     "Capacity assigned to child pools of the pool",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "vdisk_count",
+		Name:     "spectrum_pool_volume_count",
+		Desc:     "Number of volume copies in the pool",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "mdisk_count",
+		Name:     "spectrum_pool_mdisk_count",
+		Desc:     "Number of mdisks in the pool",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+}
+
+func (pv *poolProvider) Run() {
+	logger.Info("Starting provider", "endpoint", pv.clientDesc.endpoint, "provider", pv.moduleName)
+	meter := pv.meterProvider.Meter(pv.moduleName)
+
+	// Register Metrics...
+	var observableMap map[string]metric.Float64Observable
+	observableMap = provider.CreateMapMetricDescriptor(meter, PoolMetricDescs, logger)
+
+	// Register Metrics for Observables...
+	var observableArray []metric.Observable
+	for _, observable := range observableMap {
+		observableArray = append(observableArray, observable)
+	}
+
+	// ==============================
+	// Callback
+	// ==============================
+	meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+		// Client Attributes
+		clientAttrs := metric.WithAttributes(pv.clientDesc.hostLabels...)
+
+		// Request Data
+		c := pv.clientDesc.client
+		data := c.PostLsMdiskGrp()
+		if data == nil {
+			logger.Warn("data is nil", "provider", pv.moduleName, "endpoint", pv.clientDesc.endpoint)
+			return nil
+		}
+		for _, v := range data {
+			poolAttrs := metric.WithAttributes(
+				attribute.String("pool.name", v.Name),
+				attribute.String("pool.id", v.Id),
+				attribute.String("pool.type", v.Type),
+				attribute.String("pool.parent", v.ParentMdiskGrpName),
+			)
+			compressionSaving := convert.ParseUnitConvert(v.CompressionUncompressedCapacity, "mb") - convert.ParseUnitConvert(v.CompressionCompressedCapacity, "mb")
+
+			observer.ObserveFloat64(observableMap["status"], v.Status.Float64(), clientAttrs, poolAttrs)
+			observer.ObserveFloat64(observableMap["capacity"], convert.ParseUnitConvert(v.Capacity, "mb"), clientAttrs, poolAttrs)
+			observer.ObserveFloat64(observableMap["free_capacity"], convert.ParseUnitConvert(v.FreeCapacity, "mb"), clientAttrs, poolAttrs)
+			observer.ObserveFloat64(observableMap["used_capacity"], convert.ParseUnitConvert(v.UsedCapacity, "mb"), clientAttrs, poolAttrs)
+			observer.ObserveFloat64(observableMap["real_capacity"], convert.ParseUnitConvert(v.RealCapacity, "mb"), clientAttrs, poolAttrs)
+			observer.ObserveFloat64(observableMap["virtual_capacity"], convert.ParseUnitConvert(v.VirtualCapacity, "mb"), clientAttrs, poolAttrs)
+			observer.ObserveFloat64(observableMap["overallocation"], v.Overallocation.Float64(), clientAttrs, poolAttrs)
+			observer.ObserveFloat64(observableMap["warning"], v.Warning.Float64(), clientAttrs, poolAttrs)
+			observer.ObserveFloat64(observableMap["easy_tier_status"], v.EasyTierStatus.Float64(), clientAttrs, poolAttrs)
+			observer.ObserveFloat64(observableMap["compression_saving"], compressionSaving, clientAttrs, poolAttrs)
+			observer.ObserveFloat64(observableMap["deduplication_saving"], convert.ParseUnitConvert(v.DeduplicationCapacitySaving, "mb"), clientAttrs, poolAttrs)
+			observer.ObserveFloat64(observableMap["used_before_reduction"], convert.ParseUnitConvert(v.UsedCapacityBeforeReduction, "mb"), clientAttrs, poolAttrs)
+			observer.ObserveFloat64(observableMap["used_after_reduction"], convert.ParseUnitConvert(v.UsedCapacityAfterReduction, "mb"), clientAttrs, poolAttrs)
+			observer.ObserveFloat64(observableMap["reclaimable_capacity"], convert.ParseUnitConvert(v.ReclaimableCapacity, "mb"), clientAttrs, poolAttrs)
+			observer.ObserveFloat64(observableMap["child_count"], v.ChildMdiskGrpCount.Float64(), clientAttrs, poolAttrs)
+			observer.ObserveFloat64(observableMap["child_capacity"], convert.ParseUnitConvert(v.ChildMdiskGrpCapacity, "mb"), clientAttrs, poolAttrs)
+			observer.ObserveFloat64(observableMap["vdisk_count"], v.VdiskCount.Float64(), clientAttrs, poolAttrs)
+			observer.ObserveFloat64(observableMap["mdisk_count"], v.MdiskCount.Float64(), clientAttrs, poolAttrs)
+		}
+
+		return nil
+	}, observableArray...)
+
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Arinashin3/ari-agent/utils/provider"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkMetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+type portsProvider struct {
+	moduleName    string
+	interval      time.Duration
+	meterProvider *sdkMetric.MeterProvider
+	clientDesc    *ClientDesc
+}
+
+func init() {
+	moduleName := "ports"
+	registProvider(moduleName, &portsProvider{moduleName: moduleName})
+}
+
+func (pv *portsProvider) IsDefaultEnabled() bool {
+	return true
+}
+
+func (pv *portsProvider) NewProvider(moduleName string, cl *ClientDesc) Provider {
+	pvConf := cfg.Providers.Ports
+	enabled := pvConf.GetEnabled(pv.IsDefaultEnabled())
+	interval := pvConf.GetInterval()
+
+	if !enabled {
+		return nil
+	}
+	if MetricExporter == nil {
+		return nil
+	}
+	mp := provider.NewMeterProvider(serviceName, interval, MetricExporter)
+	return &portsProvider{
+		moduleName:    moduleName,
+		interval:      interval,
+		meterProvider: mp,
+		clientDesc:    cl,
+	}
+}
+
+// Status 값: 0: active, 1: inactive_unconfigured, 2: inactive_configured, 3: inactive, -1: unknown
+var PortsMetricDescs = []*provider.MetricDescriptor{
+	{
+		Key:      "fc_status",
+		Name:     "spectrum_port_fc_status",
+		Desc:     "Status of the fibre channel port",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "fc_speed",
+		Name:     "spectrum_port_fc_speed",
+		Desc:     "Negotiated speed of the fibre channel port",
+		Unit:     "gbps",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "fc_host_logins",
+		Name:     "spectrum_port_fc_host_logins",
+		Desc:     "Number of active host initiator logins on the fibre channel port",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "ip_status",
+		Name:     "spectrum_port_ip_status",
+		Desc:     "Link state of the iSCSI IP port",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "ip_speed",
+		Name:     "spectrum_port_ip_speed",
+		Desc:     "Negotiated speed of the iSCSI IP port",
+		Unit:     "gbps",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "ethernet_status",
+		Name:     "spectrum_port_ethernet_status",
+		Desc:     "Link state of the ethernet port",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "ethernet_speed",
+		Name:     "spectrum_port_ethernet_speed",
+		Desc:     "Negotiated speed of the ethernet port",
+		Unit:     "gbps",
+		TypeName: "gauge",
+	},
+}
+
+func (pv *portsProvider) Run() {
+	logger.Info("Starting provider", "endpoint", pv.clientDesc.endpoint, "provider", pv.moduleName)
+	meter := pv.meterProvider.Meter(pv.moduleName)
+
+	// Register Metrics...
+	var observableMap map[string]metric.Float64Observable
+	observableMap = provider.CreateMapMetricDescriptor(meter, PortsMetricDescs, logger)
+
+	// Register Metrics for Observables...
+	var observableArray []metric.Observable
+	for _, observable := range observableMap {
+		observableArray = append(observableArray, observable)
+	}
+
+	// ==============================
+	// Callback
+	// ==============================
+	meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+		// Client Attributes
+		clientAttrs := metric.WithAttributes(pv.clientDesc.hostLabels...)
+		c := pv.clientDesc.client
+
+		// Request Data (FC Port)
+		data := c.PostLsPortFc()
+		if data == nil {
+			logger.Warn("data is nil", "provider", pv.moduleName, "endpoint", pv.clientDesc.endpoint)
+		}
+
+		// 포트별 host initiator 로그인 수
+		hostLogins := make(map[string]float64)
+		for _, v := range c.PostLsFabric() {
+			if v.Type != "host" || v.State != "active" {
+				continue
+			}
+			hostLogins[strings.ToUpper(v.LocalWWPN)]++
+		}
+
+		for _, v := range data {
+			fcAttrs := metric.WithAttributes(
+				attribute.String("node.name", v.NodeName),
+				attribute.String("port.id", v.PortId),
+				attribute.String("port.type", v.Type),
+				attribute.String("port.wwpn", v.WWPN),
+				attribute.String("adapter.location", v.AdapterLocation),
+			)
+			observer.ObserveFloat64(observableMap["fc_status"], v.Status.Float64(), clientAttrs, fcAttrs)
+			observer.ObserveFloat64(observableMap["fc_speed"], v.PortSpeed.Float64(), clientAttrs, fcAttrs)
+			observer.ObserveFloat64(observableMap["fc_host_logins"], hostLogins[strings.ToUpper(v.WWPN)], clientAttrs, fcAttrs)
+		}
+
+		// Request Data (IP Port)
+		iqnMap := make(map[string]string)
+		for _, v := range c.PostLsNodeCanister() {
+			iqnMap[v.Id] = v.IscsiName
+		}
+		for _, v := range c.PostLsPortIp() {
+			// 각 포트는 failover=no, failover=yes 두 항목으로 조회되므로 failover=no 만 사용
+			if v.Failover == "yes" {
+				continue
+			}
+			ipAttrs := metric.WithAttributes(
+				attribute.String("node.name", v.NodeName),
+				attribute.String("port.id", v.Id),
+				attribute.String("port.mac", v.MAC),
+				attribute.String("port.iqn", iqnMap[v.NodeId]),
+				attribute.String("ip.address", v.IPAddress),
+			)
+			observer.ObserveFloat64(observableMap["ip_status"], v.LinkState.Float64(), clientAttrs, ipAttrs)
+			observer.ObserveFloat64(observableMap["ip_speed"], v.Speed.Float64(), clientAttrs, ipAttrs)
+		}
+
+		// Request Data (Ethernet Port)
+		for _, v := range c.PostLsPortEthernet() {
+			portId := v.PortId
+			if portId == "" {
+				portId = v.Id
+			}
+			ethAttrs := metric.WithAttributes(
+				attribute.String("node.name", v.NodeName),
+				attribute.String("port.id", portId),
+				attribute.String("port.mac", v.MAC),
+				attribute.String("adapter.location", v.AdapterLocation),
+			)
+			observer.ObserveFloat64(observableMap["ethernet_status"], v.LinkState.Float64(), clientAttrs, ethAttrs)
+			observer.ObserveFloat64(observableMap["ethernet_speed"], v.Speed.Float64(), clientAttrs, ethAttrs)
+		}
+
+		return nil
+	}, observableArray...)
+
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/Arinashin3/ari-agent/utils/provider"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkMetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+type replicationProvider struct {
+	moduleName    string
+	interval      time.Duration
+	meterProvider *sdkMetric.MeterProvider
+	clientDesc    *ClientDesc
+}
+
+func init() {
+	moduleName := "replication"
+	registProvider(moduleName, &replicationProvider{moduleName: moduleName})
+}
+
+func (pv *replicationProvider) IsDefaultEnabled() bool {
+	return true
+}
+
+func (pv *replicationProvider) NewProvider(moduleName string, cl *ClientDesc) Provider {
+	pvConf := cfg.Providers.Replication
+	enabled := pvConf.GetEnabled(pv.IsDefaultEnabled())
+	interval := pvConf.GetInterval()
+
+	if !enabled {
+		return nil
+	}
+	if MetricExporter == nil {
+		return nil
+	}
+	mp := provider.NewMeterProvider(serviceName, interval, MetricExporter)
+	return &replicationProvider{
+		moduleName:    moduleName,
+		interval:      interval,
+		meterProvider: mp,
+		clientDesc:    cl,
+	}
+}
+
+// State 값: 0: consistent_synchronized, 1: consistent_copying, 2: inconsistent_copying, 3: consistent_stopped,
+// 4: inconsistent_stopped, 5: idling, 6: idling_disconnected, 7: consistent_disconnected,
+// 8: inconsistent_disconnected, 9: empty, -1: unknown
+var ReplicationMetricDescs = []*provider.MetricDescriptor{
+	{
+		Key:      "relationship_state",
+		Name:     "spectrum_replication_relationship_state",
+		Desc:     "State of the remote-copy relationship",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "relationship_progress",
+		Name:     "spectrum_replication_relationship_progress",
+		Desc:     "Background copy progress of the remote-copy relationship",
+		Unit:     "%",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "relationship_freeze_time",
+		Name:     "spectrum_replication_relationship_freeze",
+		Desc:     "Time of the last consistent image of the remote-copy relationship",
+		Unit:     "timestamp",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "relationship_rpo_lag",
+		Name:     "spectrum_replication_relationship_rpo_lag",
+		Desc:     "Elapsed time since the last consistent image of the remote-copy relationship",
+		Unit:     "s",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "consistgrp_state",
+		Name:     "spectrum_replication_consistgrp_state",
+		Desc:     "State of the remote-copy consistency group",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "consistgrp_relationship_count",
+		Name:     "spectrum_replication_consistgrp_relationship_count",
+		Desc:     "Number of relationships in the remote-copy consistency group",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "consistgrp_freeze_time",
+		Name:     "spectrum_replication_consistgrp_freeze",
+		Desc:     "Time of the last consistent image of the remote-copy consistency group",
+		Unit:     "timestamp",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "consistgrp_rpo_lag",
+		Name:     "spectrum_replication_consistgrp_rpo_lag",
+		Desc:     "Elapsed time since the last consistent image of the remote-copy consistency group",
+		Unit:     "s",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "partnership_status",
+		Name:     "spectrum_replication_partnership_status",
+		Desc:     "Status of the partnership with the remote system (0: fully_configured, -1: unknown, others: not fully configured)",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+}
+
+func (pv *replicationProvider) Run() {
+	logger.Info("Starting provider", "endpoint", pv.clientDesc.endpoint, "provider", pv.moduleName)
+	meter := pv.meterProvider.Meter(pv.moduleName)
+
+	// Register Metrics...
+	var observableMap map[string]metric.Float64Observable
+	observableMap = provider.CreateMapMetricDescriptor(meter, ReplicationMetricDescs, logger)
+
+	// Register Metrics for Observables...
+	var observableArray []metric.Observable
+	for _, observable := range observableMap {
+		observableArray = append(observableArray, observable)
+	}
+
+	// ==============================
+	// Callback
+	// ==============================
+	meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+		// Client Attributes
+		clientAttrs := metric.WithAttributes(pv.clientDesc.hostLabels...)
+		c := pv.clientDesc.client
+		now := time.Now()
+		loc := c.Location()
+
+		// Request Data (Partnership)
+		for _, v := range c.PostLsPartnership() {
+			if v.Location == "local" {
+				continue
+			}
+			partnershipAttrs := metric.WithAttributes(
+				attribute.String("partnership.id", v.Id),
+				attribute.String("partnership.name", v.Name),
+				attribute.String("partnership.type", v.Type),
+			)
+			observer.ObserveFloat64(observableMap["partnership_status"], v.Partnership.Float64(), clientAttrs, partnershipAttrs)
+		}
+
+		// Request Data (Consistency Group)
+		for _, v := range c.PostLsRcConsistGrp() {
+			groupAttrs := metric.WithAttributes(
+				attribute.String("rc.group", v.Name),
+				attribute.String("rc.copy_type", v.CopyType),
+				attribute.String("master.cluster", v.MasterClusterName),
+				attribute.String("aux.cluster", v.AuxClusterName),
+				attribute.String("primary", v.Primary),
+			)
+			observer.ObserveFloat64(observableMap["consistgrp_state"], v.State.Float64(), clientAttrs, groupAttrs)
+			observer.ObserveFloat64(observableMap["consistgrp_relationship_count"], v.RelationshipCount.Float64(), clientAttrs, groupAttrs)
+			if freezeTime, err := v.FreezeTime.Time(loc); err == nil {
+				observer.ObserveFloat64(observableMap["consistgrp_freeze_time"], float64(freezeTime.Unix()), clientAttrs, groupAttrs)
+				observer.ObserveFloat64(observableMap["consistgrp_rpo_lag"], now.Sub(freezeTime).Seconds(), clientAttrs, groupAttrs)
+			}
+		}
+
+		// Request Data (Relationship)
+		data := c.PostLsRcRelationship()
+		if data == nil {
+			logger.Warn("data is nil", "provider", pv.moduleName, "endpoint", pv.clientDesc.endpoint)
+			return nil
+		}
+		for _, v := range data {
+			rcAttrs := metric.WithAttributes(
+				attribute.String("rc.name", v.Name),
+				attribute.String("rc.group", v.ConsistencyGroupName),
+				attribute.String("rc.copy_type", v.CopyType),
+				attribute.String("master.vdisk", v.MasterVdiskName),
+				attribute.String("aux.vdisk", v.AuxVdiskName),
+				attribute.String("aux.cluster", v.AuxClusterName),
+				attribute.String("primary", v.Primary),
+			)
+			observer.ObserveFloat64(observableMap["relationship_state"], v.State.Float64(), clientAttrs, rcAttrs)
+			if v.Progress != "" {
+				observer.ObserveFloat64(observableMap["relationship_progress"], v.Progress.Float64(), clientAttrs, rcAttrs)
+			}
+			if freezeTime, err := v.FreezeTime.Time(loc); err == nil {
+				observer.ObserveFloat64(observableMap["relationship_freeze_time"], float64(freezeTime.Unix()), clientAttrs, rcAttrs)
+				observer.ObserveFloat64(observableMap["relationship_rpo_lag"], now.Sub(freezeTime).Seconds(), clientAttrs, rcAttrs)
+			}
+		}
+
+		return nil
+	}, observableArray...)
+
+}
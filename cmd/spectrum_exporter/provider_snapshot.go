@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/Arinashin3/ari-agent/utils/provider"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkMetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+type snapshotProvider struct {
+	moduleName    string
+	interval      time.Duration
+	meterProvider *sdkMetric.MeterProvider
+	clientDesc    *ClientDesc
+}
+
+func init() {
+	moduleName := "snapshot"
+	registProvider(moduleName, &snapshotProvider{moduleName: moduleName})
+}
+
+func (pv *snapshotProvider) IsDefaultEnabled() bool {
+	return true
+}
+
+func (pv *snapshotProvider) NewProvider(moduleName string, cl *ClientDesc) Provider {
+	pvConf := cfg.Providers.Snapshot
+	enabled := pvConf.GetEnabled(pv.IsDefaultEnabled())
+	interval := pvConf.GetInterval()
+
+	if !enabled {
+		return nil
+	}
+	if MetricExporter == nil {
+		return nil
+	}
+	mp := provider.NewMeterProvider(serviceName, interval, MetricExporter)
+	return &snapshotProvider{
+		moduleName:    moduleName,
+		interval:      interval,
+		meterProvider: mp,
+		clientDesc:    cl,
+	}
+}
+
+var SnapshotMetricDescs = []*provider.MetricDescriptor{
+	{
+		Key:      "volume_count",
+		Name:     "spectrum_volumegroup_volume_count",
+		Desc:     "Number of volumes in the volume group",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "snapshot_count",
+		Name:     "spectrum_volumegroup_snapshot_count",
+		Desc:     "Number of snapshots of the volume group",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "oldest_age",
+		Name:     "spectrum_volumegroup_snapshot_oldest_age",
+		Desc:     "Age of the oldest snapshot of the volume group",
+		Unit:     "s",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "newest_age",
+		Name:     "spectrum_volumegroup_snapshot_newest_age",
+		Desc:     "Age of the newest snapshot of the volume group",
+		Unit:     "s",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "policy_suspended",
+		Name:     "spectrum_volumegroup_snapshot_policy_suspended",
+		Desc:     "Whether the snapshot policy of the volume group is suspended",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+}
+
+func (pv *snapshotProvider) Run() {
+	logger.Info("Starting provider", "endpoint", pv.clientDesc.endpoint, "provider", pv.moduleName)
+	meter := pv.meterProvider.Meter(pv.moduleName)
+
+	// Register Metrics...
+	var observableMap map[string]metric.Float64Observable
+	observableMap = provider.CreateMapMetricDescriptor(meter, SnapshotMetricDescs, logger)
+
+	// Register Metrics for Observables...
+	var observableArray []metric.Observable
+	for _, observable := range observableMap {
+		observableArray = append(observableArray, observable)
+	}
+
+	// ==============================
+	// Callback
+	// ==============================
+	meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+		// Client Attributes
+		clientAttrs := metric.WithAttributes(pv.clientDesc.hostLabels...)
+
+		// Request Data
+		c := pv.clientDesc.client
+		data := c.PostLsVolumeGroup()
+		if data == nil {
+			logger.Warn("data is nil", "provider", pv.moduleName, "endpoint", pv.clientDesc.endpoint)
+			return nil
+		}
+
+		// Snapshots (volume group 별 개수, 가장 오래된/최근 생성 시간)
+		loc := c.Location()
+		now := time.Now()
+		snapshotCount := make(map[string]float64)
+		oldest := make(map[string]time.Time)
+		newest := make(map[string]time.Time)
+		for _, v := range c.PostLsSnapshot() {
+			snapshotCount[v.VolumeGroupId]++
+			created, err := v.Created(loc)
+			if err != nil {
+				continue
+			}
+			if t, ok := oldest[v.VolumeGroupId]; !ok || created.Before(t) {
+				oldest[v.VolumeGroupId] = created
+			}
+			if t, ok := newest[v.VolumeGroupId]; !ok || created.After(t) {
+				newest[v.VolumeGroupId] = created
+			}
+		}
+
+		for _, v := range data {
+			policyName := v.SnapshotPolicyName
+			if policyName == "" {
+				policyName = v.SafeguardedPolicyName
+			}
+			groupAttrs := metric.WithAttributes(
+				attribute.String("volumegroup.name", v.Name),
+				attribute.String("volumegroup.id", v.Id),
+				attribute.String("policy.name", policyName),
+			)
+			observer.ObserveFloat64(observableMap["volume_count"], v.VolumeCount.Float64(), clientAttrs, groupAttrs)
+			observer.ObserveFloat64(observableMap["snapshot_count"], snapshotCount[v.Id], clientAttrs, groupAttrs)
+			if t, ok := oldest[v.Id]; ok {
+				observer.ObserveFloat64(observableMap["oldest_age"], now.Sub(t).Seconds(), clientAttrs, groupAttrs)
+			}
+			if t, ok := newest[v.Id]; ok {
+				observer.ObserveFloat64(observableMap["newest_age"], now.Sub(t).Seconds(), clientAttrs, groupAttrs)
+			}
+			if v.SnapshotPolicySuspended != "" {
+				observer.ObserveFloat64(observableMap["policy_suspended"], v.SnapshotPolicySuspended.Float64(), clientAttrs, groupAttrs)
+			}
+		}
+
+		return nil
+	}, observableArray...)
+
+}
@@ -90,6 +90,41 @@ var SystemMetricDescs = []*provider.MetricDescriptor{
 		Unit:     "mb",
 		TypeName: "gauge",
 	},
+	{
+		Key:      "cert_expiry",
+		Name:     "spectrum_system_cert_expiry",
+		Desc:     "Expiry time of the system certificate",
+		Unit:     "timestamp",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "license_licensed",
+		Name:     "spectrum_system_license_licensed",
+		Desc:     "Licensed value of the feature",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "license_used",
+		Name:     "spectrum_system_license_used",
+		Desc:     "Used value of the licensed feature",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "update_status",
+		Name:     "spectrum_system_update_status",
+		Desc:     "Status of the software update (0: success, 1: preparing, 2: prepared, 3: updating, 4: completion_required, 5: paused, 6: aborting, 7: stalled, 8: prepare_failed, -1: unknown)",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "update_progress",
+		Name:     "spectrum_system_update_progress",
+		Desc:     "Progress of the software update",
+		Unit:     "%",
+		TypeName: "gauge",
+	},
 }
 
 func (pv *systemProvider) Run() {
@@ -134,6 +169,36 @@ func (pv *systemProvider) Run() {
 		observer.ObserveFloat64(observableMap["TotalFreeSpace"], convert.ParseUnitConvert(data.TotalFreeSpace, "mb"), clientAttrs)
 		observer.ObserveFloat64(observableMap["SpaceAllocatedToVdisks"], convert.ParseUnitConvert(data.SpaceAllocatedToVdisks, "mb"), clientAttrs)
 
+		// Certificate
+		if cert := c.PostLsSystemCert(); cert != nil {
+			expiry, err := cert.Expiry()
+			if err != nil {
+				logger.Warn("Failed to parse certificate expiry", "provider", pv.moduleName, "endpoint", pv.clientDesc.endpoint, "error", err)
+			} else {
+				certAttrs := metric.WithAttributes(
+					attribute.String("cert.type", cert.Type),
+					attribute.String("cert.issuer", cert.Issuer),
+				)
+				observer.ObserveFloat64(observableMap["cert_expiry"], float64(expiry.Unix()), clientAttrs, certAttrs)
+			}
+		}
+
+		// License
+		for _, v := range c.PostLsLicense().Usages() {
+			licenseAttrs := metric.WithAttributes(attribute.String("license.feature", v.Feature))
+			observer.ObserveFloat64(observableMap["license_licensed"], v.Licensed.Float64(), clientAttrs, licenseAttrs)
+			observer.ObserveFloat64(observableMap["license_used"], v.Used.Float64(), clientAttrs, licenseAttrs)
+		}
+
+		// Software Update
+		if update := c.PostLsUpdate(); update != nil {
+			updateAttrs := metric.WithAttributes(attribute.String("target.version", update.SystemNewCodeLevel))
+			observer.ObserveFloat64(observableMap["update_status"], update.Status.Float64(), clientAttrs, updateAttrs)
+			if update.Progress != "" {
+				observer.ObserveFloat64(observableMap["update_progress"], update.Progress.Float64(), clientAttrs, updateAttrs)
+			}
+		}
+
 		return nil
 	}, observableArray...)
 
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/Arinashin3/ari-agent/client/spectrum"
+	"github.com/Arinashin3/ari-agent/utils/provider"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkMetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+type topologyProvider struct {
+	moduleName    string
+	interval      time.Duration
+	meterProvider *sdkMetric.MeterProvider
+	clientDesc    *ClientDesc
+}
+
+func init() {
+	moduleName := "topology"
+	registProvider(moduleName, &topologyProvider{moduleName: moduleName})
+}
+
+func (pv *topologyProvider) IsDefaultEnabled() bool {
+	return true
+}
+
+func (pv *topologyProvider) NewProvider(moduleName string, cl *ClientDesc) Provider {
+	pvConf := cfg.Providers.Topology
+	enabled := pvConf.GetEnabled(pv.IsDefaultEnabled())
+	interval := pvConf.GetInterval()
+
+	if !enabled {
+		return nil
+	}
+	if MetricExporter == nil {
+		return nil
+	}
+	mp := provider.NewMeterProvider(serviceName, interval, MetricExporter)
+	return &topologyProvider{
+		moduleName:    moduleName,
+		interval:      interval,
+		meterProvider: mp,
+		clientDesc:    cl,
+	}
+}
+
+// Topology Status 값: 0: dual_site, 1: recovered_site_1, 2: recovered_site_2, -1: unknown
+// (standard topology 는 topology_status 가 없으므로 전송하지 않음)
+// Site Status 값: 0: online, 1: degraded, 2: offline
+// Quorum Status 값: 0: online, 2: offline, 8: excluded, -1: unknown
+var TopologyMetricDescs = []*provider.MetricDescriptor{
+	{
+		Key:      "status",
+		Name:     "spectrum_topology_status",
+		Desc:     "Status of the system topology",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "site_status",
+		Name:     "spectrum_site_status",
+		Desc:     "Status of the site derived from the state of its nodes",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "site_nodes_online",
+		Name:     "spectrum_site_nodes_online",
+		Desc:     "Number of online nodes in the site",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "site_nodes_total",
+		Name:     "spectrum_site_nodes_total",
+		Desc:     "Number of nodes in the site",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "quorum_status",
+		Name:     "spectrum_quorum_status",
+		Desc:     "Status of the quorum device",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "quorum_active",
+		Name:     "spectrum_quorum_active",
+		Desc:     "Whether the quorum device is the active quorum device",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "iogroup_node_count",
+		Name:     "spectrum_iogroup_node_count",
+		Desc:     "Number of nodes in the IO group",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "iogroup_volume_count",
+		Name:     "spectrum_iogroup_volume_count",
+		Desc:     "Number of volumes owned by the IO group",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "iogroup_host_count",
+		Name:     "spectrum_iogroup_host_count",
+		Desc:     "Number of hosts mapped through the IO group",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+}
+
+func (pv *topologyProvider) Run() {
+	logger.Info("Starting provider", "endpoint", pv.clientDesc.endpoint, "provider", pv.moduleName)
+	meter := pv.meterProvider.Meter(pv.moduleName)
+
+	// Register Metrics...
+	var observableMap map[string]metric.Float64Observable
+	observableMap = provider.CreateMapMetricDescriptor(meter, TopologyMetricDescs, logger)
+
+	// Register Metrics for Observables...
+	var observableArray []metric.Observable
+	for _, observable := range observableMap {
+		observableArray = append(observableArray, observable)
+	}
+
+	// ==============================
+	// Callback
+	// ==============================
+	meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+		// Client Attributes
+		clientAttrs := metric.WithAttributes(pv.clientDesc.hostLabels...)
+
+		// Request Data
+		c := pv.clientDesc.client
+		data := c.PostLsSystem()
+		if data == nil {
+			logger.Warn("data is nil", "provider", pv.moduleName, "endpoint", pv.clientDesc.endpoint)
+			return nil
+		}
+
+		// Topology
+		topologyAttrs := metric.WithAttributes(
+			attribute.String("topology", data.Topology),
+			attribute.String("quorum.mode", data.QuorumMode),
+			attribute.String("quorum.site", data.QuorumSiteName),
+		)
+		if data.Topology != "standard" && data.TopologyStatus != "" {
+			observer.ObserveFloat64(observableMap["status"], spectrum.TopologyStatus(data.TopologyStatus).Float64(), clientAttrs, topologyAttrs)
+		}
+
+		// Sites (node 상태로 site 상태를 판단)
+		siteNames := make(map[string]string)
+		siteOnline := make(map[string]float64)
+		siteTotal := make(map[string]float64)
+		for _, v := range c.PostLsNodeCanister() {
+			if v.SiteId == "" {
+				continue
+			}
+			siteNames[v.SiteId] = v.SiteName
+			siteTotal[v.SiteId]++
+			if v.Status == spectrum.ComponentStatusEnumOnline {
+				siteOnline[v.SiteId]++
+			}
+		}
+		for siteId, siteName := range siteNames {
+			siteAttrs := metric.WithAttributes(
+				attribute.String("site.id", siteId),
+				attribute.String("site.name", siteName),
+			)
+			siteStatus := 1.0
+			switch siteOnline[siteId] {
+			case siteTotal[siteId]:
+				siteStatus = 0.0
+			case 0:
+				siteStatus = 2.0
+			}
+			observer.ObserveFloat64(observableMap["site_status"], siteStatus, clientAttrs, siteAttrs)
+			observer.ObserveFloat64(observableMap["site_nodes_online"], siteOnline[siteId], clientAttrs, siteAttrs)
+			observer.ObserveFloat64(observableMap["site_nodes_total"], siteTotal[siteId], clientAttrs, siteAttrs)
+		}
+
+		// Quorum Devices
+		for _, v := range c.PostLsQuorum() {
+			quorumAttrs := metric.WithAttributes(
+				attribute.String("quorum.index", v.QuorumIndex),
+				attribute.String("quorum.name", v.Name),
+				attribute.String("quorum.type", v.ObjectType),
+				attribute.String("controller.name", v.ControllerName),
+				attribute.String("site.name", v.SiteName),
+			)
+			observer.ObserveFloat64(observableMap["quorum_status"], v.Status.Float64(), clientAttrs, quorumAttrs)
+			observer.ObserveFloat64(observableMap["quorum_active"], v.Active.Float64(), clientAttrs, quorumAttrs)
+		}
+
+		// IO Groups
+		for _, v := range c.PostLsIOGrp() {
+			iogrpAttrs := metric.WithAttributes(
+				attribute.String("iogroup.name", v.Name),
+				attribute.String("iogroup.id", v.Id),
+				attribute.String("site.name", v.SiteName),
+			)
+			observer.ObserveFloat64(observableMap["iogroup_node_count"], v.NodeCount.Float64(), clientAttrs, iogrpAttrs)
+			observer.ObserveFloat64(observableMap["iogroup_volume_count"], v.VdiskCount.Float64(), clientAttrs, iogrpAttrs)
+			observer.ObserveFloat64(observableMap["iogroup_host_count"], v.HostCount.Float64(), clientAttrs, iogrpAttrs)
+		}
+
+		return nil
+	}, observableArray...)
+
+}
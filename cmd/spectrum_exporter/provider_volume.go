@@ -0,0 +1,200 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/Arinashin3/ari-agent/client/spectrum"
+	"github.com/Arinashin3/ari-agent/utils/convert"
+	"github.com/Arinashin3/ari-agent/utils/provider"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkMetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+type volumeProvider struct {
+	moduleName    string
+	interval      time.Duration
+	meterProvider *sdkMetric.MeterProvider
+	clientDesc    *ClientDesc
+}
+
+func init() {
+	moduleName := "volume"
+	registProvider(moduleName, &volumeProvider{moduleName: moduleName})
+}
+
+func (pv *volumeProvider) IsDefaultEnabled() bool {
+	return false
+}
+
+func (pv *volumeProvider) NewProvider(moduleName string, cl *ClientDesc) Provider {
+	pvConf := cfg.Providers.Volume
+	enabled := pvConf.GetEnabled(pv.IsDefaultEnabled())
+	interval := pvConf.GetInterval()
+
+	if !enabled {
+		return nil
+	}
+	if MetricExporter == nil {
+		return nil
+	}
+	mp := provider.NewMeterProvider(serviceName, interval, MetricExporter)
+	return &volumeProvider{
+		moduleName:    moduleName,
+		interval:      interval,
+		meterProvider: mp,
+		clientDesc:    cl,
+	}
+}
+
+var VolumeMetricDescs = []*provider.MetricDescriptor{
+	{
+		Key:      "capacity",
+		Name:     "spectrum_volume_capacity",
+		Desc:     "Virtual capacity of the volume",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "status",
+		Name:     "spectrum_volume_status",
+		Desc:     "Status of the volume (0: online, 1: degraded, 2: offline)",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "copy_count",
+		Name:     "spectrum_volume_copy_count",
+		Desc:     "Number of copies of the volume",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "copy_status",
+		Name:     "spectrum_volume_copy_status",
+		Desc:     "Status of the volume copy (0: online, 1: degraded, 2: offline)",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "copy_used_capacity",
+		Name:     "spectrum_volume_copy_used_capacity",
+		Desc:     "Used capacity of the volume copy",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "copy_real_capacity",
+		Name:     "spectrum_volume_copy_real_capacity",
+		Desc:     "Real capacity allocated in the pool for the volume copy",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "copy_thin",
+		Name:     "spectrum_volume_copy_thin",
+		Desc:     "Whether the volume copy is thin-provisioned",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "copy_compressed",
+		Name:     "spectrum_volume_copy_compressed",
+		Desc:     "Whether the volume copy is compressed",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "copy_deduplicated",
+		Name:     "spectrum_volume_copy_deduplicated",
+		Desc:     "Whether the volume copy is deduplicated",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+}
+
+func (pv *volumeProvider) Run() {
+	logger.Info("Starting provider", "endpoint", pv.clientDesc.endpoint, "provider", pv.moduleName)
+	meter := pv.meterProvider.Meter(pv.moduleName)
+
+	// Register Metrics...
+	var observableMap map[string]metric.Float64Observable
+	observableMap = provider.CreateMapMetricDescriptor(meter, VolumeMetricDescs, logger)
+
+	// Register Metrics for Observables...
+	var observableArray []metric.Observable
+	for _, observable := range observableMap {
+		observableArray = append(observableArray, observable)
+	}
+
+	// ==============================
+	// Callback
+	// ==============================
+	meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+		// Client Attributes
+		clientAttrs := metric.WithAttributes(pv.clientDesc.hostLabels...)
+
+		// Request Data
+		c := pv.clientDesc.client
+		data := c.PostLsVdisk()
+		if data == nil {
+			logger.Warn("data is nil", "provider", pv.moduleName, "endpoint", pv.clientDesc.endpoint)
+			return nil
+		}
+		volumeAttrsMap := make(map[string][]attribute.KeyValue)
+		for _, v := range data {
+			attrs := []attribute.KeyValue{
+				attribute.String("volume.name", v.Name),
+				attribute.String("volume.id", v.Id),
+				attribute.String("volume.uid", v.VdiskUID),
+				attribute.String("iogroup.name", v.IOGroupName),
+			}
+			volumeAttrsMap[v.Id] = attrs
+			volumeAttrs := metric.WithAttributes(attrs...)
+			observer.ObserveFloat64(observableMap["capacity"], convert.ParseUnitConvert(v.Capacity, "mb"), clientAttrs, volumeAttrs)
+			observer.ObserveFloat64(observableMap["status"], v.Status.Float64(), clientAttrs, volumeAttrs)
+			observer.ObserveFloat64(observableMap["copy_count"], v.CopyCount.Float64(), clientAttrs, volumeAttrs)
+		}
+
+		// Copies
+		copies := c.PostLsVdiskCopy()
+		if copies == nil {
+			logger.Warn("copy data is nil", "provider", pv.moduleName, "endpoint", pv.clientDesc.endpoint)
+			return nil
+		}
+
+		// thin/compressed copy 의 used/real capacity 는 lssevdiskcopy 에서만 확인 가능
+		seCopyMap := make(map[string]*spectrum.LsSeVdiskCopyInst)
+		for _, v := range c.PostLsSeVdiskCopy() {
+			seCopyMap[v.VdiskId+":"+v.CopyId] = v
+		}
+
+		for _, v := range copies {
+			attrs, ok := volumeAttrsMap[v.VdiskId]
+			if !ok {
+				continue
+			}
+			copyAttrs := metric.WithAttributes(append(attrs,
+				attribute.String("copy.id", v.CopyId),
+				attribute.String("pool.name", v.MdiskGrpName),
+			)...)
+
+			usedCapacity := convert.ParseUnitConvert(v.Capacity, "mb")
+			realCapacity := usedCapacity
+			if se, ok := seCopyMap[v.VdiskId+":"+v.CopyId]; ok {
+				usedCapacity = convert.ParseUnitConvert(se.UsedCapacity, "mb")
+				realCapacity = convert.ParseUnitConvert(se.RealCapacity, "mb")
+			}
+			observer.ObserveFloat64(observableMap["copy_status"], v.Status.Float64(), clientAttrs, copyAttrs)
+			observer.ObserveFloat64(observableMap["copy_used_capacity"], usedCapacity, clientAttrs, copyAttrs)
+			observer.ObserveFloat64(observableMap["copy_real_capacity"], realCapacity, clientAttrs, copyAttrs)
+			observer.ObserveFloat64(observableMap["copy_thin"], v.SeCopy.Float64(), clientAttrs, copyAttrs)
+			observer.ObserveFloat64(observableMap["copy_compressed"], v.CompressedCopy.Float64(), clientAttrs, copyAttrs)
+			observer.ObserveFloat64(observableMap["copy_deduplicated"], v.DeduplicatedCopy.Float64(), clientAttrs, copyAttrs)
+		}
+
+		return nil
+	}, observableArray...)
+
+}
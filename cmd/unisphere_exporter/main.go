@@ -7,9 +7,9 @@ import (
 	"os"
 	"strconv"
 
+	"github.com/Arinashin3/ari-agent/client/unisphere"
 	"github.com/Arinashin3/ari-agent/config/cfgUnisphere"
 	"github.com/Arinashin3/ari-agent/utils/provider"
-	"github.com/Arinashin3/gounity"
 	"github.com/alecthomas/kingpin/v2"
 	"github.com/prometheus/common/promslog"
 	promslogflag "github.com/prometheus/common/promslog/flag"
@@ -43,7 +43,7 @@ type ClientDesc struct {
 	endpoint     string
 	customLabels []attribute.KeyValue
 	hostLabels   []attribute.KeyValue
-	client       *gounity.UnisphereClient
+	client       *unisphere.UnisphereClient
 }
 
 type Provider interface {
@@ -121,11 +121,11 @@ func RegistryProviders() {
 			customLabels = append(customLabels, attribute.String(k, v))
 		}
 		username, password := cfg.SearchAuth(clientConf.Auth)
-		if username != "" || password != "" {
+		if username == "" && password == "" {
 			logger.Error("Cannot found the authentication credentials.", "auth", clientConf.Auth)
 		}
 		insecure, _ = strconv.ParseBool(clientConf.Insecure)
-		cm := gounity.NewClient(endpoint, username, password, insecure)
+		cm := unisphere.NewClient(endpoint, username, password, insecure)
 
 		cl := &ClientDesc{
 			endpoint:     endpoint,
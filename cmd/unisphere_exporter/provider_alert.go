@@ -0,0 +1,212 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Arinashin3/ari-agent/client/unisphere"
+	"github.com/Arinashin3/ari-agent/utils/provider"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/metric"
+	sdkLog "go.opentelemetry.io/otel/sdk/log"
+	sdkMetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+func init() {
+	moduleName := "alert"
+	registProvider(moduleName, &alertProvider{moduleName: moduleName})
+}
+
+func (pv *alertProvider) IsDefaultEnabled() bool {
+	return true
+}
+
+func (pv *alertProvider) NewProvider(moduleName string, cl *ClientDesc) Provider {
+	pvConf := cfg.Providers.Alert
+	enabled := pvConf.GetEnabled(pv.IsDefaultEnabled())
+	interval := pvConf.GetInterval()
+
+	if !enabled {
+		return nil
+	}
+	if LogExporter == nil && MetricExporter == nil {
+		return nil
+	}
+	var lp *sdkLog.LoggerProvider
+	if LogExporter != nil {
+		lp = provider.NewLoggerProvider(serviceName, interval, LogExporter)
+	}
+	var mp *sdkMetric.MeterProvider
+	if MetricExporter != nil {
+		mp = provider.NewMeterProvider(serviceName, interval, MetricExporter)
+	}
+	return &alertProvider{
+		moduleName:     moduleName,
+		interval:       interval,
+		loggerProvider: lp,
+		meterProvider:  mp,
+		clientDesc:     cl,
+		alerts:         make(map[string]unisphere.AlertContent),
+	}
+}
+
+type alertProvider struct {
+	moduleName     string
+	interval       time.Duration
+	loggerProvider *sdkLog.LoggerProvider
+	meterProvider  *sdkMetric.MeterProvider
+	clientDesc     *ClientDesc
+	// 현재 열려있는(Inactive 가 아닌) alert 목록
+	mu     sync.Mutex
+	alerts map[string]unisphere.AlertContent
+}
+
+var alertMetricDescs = []*provider.MetricDescriptor{
+	{
+		Key:      "open",
+		Name:     "unisphere_alert_open",
+		Desc:     "Number of open alerts of unisphere",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+}
+
+func (pv *alertProvider) Run() {
+	logger.Info("Starting provider", "endpoint", pv.clientDesc.endpoint, "provider", pv.moduleName)
+	if pv.meterProvider != nil {
+		pv.registerMetrics()
+	}
+
+	ctx := context.Background()
+	ctime := time.Now().Add(-time.Hour)
+	uc := pv.clientDesc.client
+	initialized := false
+
+	var fields = []string{
+		"timestamp",
+		"severity",
+		"component",
+		"messageId",
+		"message",
+		"resolution",
+		"isAcknowledged",
+		"state",
+	}
+	filter := unisphere.NewFilter().Ne("state", unisphere.AlertStateInactive)
+
+	for {
+		data, err := uc.GetAlertInstances(fields, filter)
+		if err != nil {
+			logger.Error("Error to GET Alert", "err", err)
+			time.Sleep(pv.interval)
+			continue
+		}
+
+		var pvlogger log.Logger
+		if pv.loggerProvider != nil {
+			pvlogger = pv.loggerProvider.Logger(pv.moduleName, log.WithInstrumentationAttributes(pv.clientDesc.hostLabels...))
+		}
+		emit := func(content unisphere.AlertContent, lifecycle string, timestamp time.Time) {
+			if pvlogger == nil {
+				return
+			}
+			record := log.Record{}
+			record.SetTimestamp(timestamp)
+			record.SetObservedTimestamp(time.Now())
+			record.SetBody(log.StringValue(content.Message))
+			record.AddAttributes(
+				log.String("level", content.Severity.String()),
+				log.String("alert.id", content.Id),
+				log.String("alert.state", lifecycle),
+				log.String("message.id", content.MessageId),
+				log.String("component", content.Component.Id),
+				log.String("resolution", content.Resolution),
+			)
+			pvlogger.Emit(ctx, record)
+		}
+
+		current := make(map[string]unisphere.AlertContent)
+		for _, entry := range data.Entries {
+			content := entry.Content
+			current[content.Id] = content
+
+			prev, ok := pv.alerts[content.Id]
+			switch {
+			case !ok:
+				// 최초 실행 시에는 최근 1시간 이내에 발생한 alert 만 전송
+				if initialized || content.Timestamp.After(ctime) {
+					emit(content, "raised", content.Timestamp)
+				}
+			case content.IsAcknowledged && !prev.IsAcknowledged:
+				emit(content, "acknowledged", time.Now())
+			}
+		}
+		// 목록에서 사라진 alert 는 해제(Inactive)된 것으로 판단
+		for id, prev := range pv.alerts {
+			if _, ok := current[id]; !ok {
+				emit(prev, "cleared", time.Now())
+			}
+		}
+
+		pv.mu.Lock()
+		pv.alerts = current
+		pv.mu.Unlock()
+		initialized = true
+
+		time.Sleep(pv.interval)
+	}
+}
+
+func (pv *alertProvider) registerMetrics() {
+	meter := pv.meterProvider.Meter(pv.moduleName)
+
+	// Register Metrics...
+	var observableMap map[string]metric.Float64Observable
+	observableMap = provider.CreateMapMetricDescriptor(meter, alertMetricDescs, logger)
+
+	// Register Metrics for Observables...
+	var observableArray []metric.Observable
+	for _, obserable := range observableMap {
+		observableArray = append(observableArray, obserable)
+	}
+
+	// Callback
+	meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+
+		// Client Attributes
+		if pv.clientDesc.hostLabels == nil {
+			return errors.New("hostLabels not set")
+		}
+		clientAttrs := metric.WithAttributes(pv.clientDesc.hostLabels...)
+
+		type alertKey struct {
+			severity     unisphere.SeverityEnum
+			acknowledged bool
+		}
+		counts := make(map[alertKey]float64)
+		// 알려진 severity 는 0 으로도 전송하여 alert 가 해제되었을 때 값이 남지 않도록 함
+		for severity := unisphere.SeverityEnumEmergency; severity <= unisphere.SeverityEnumWarning; severity++ {
+			counts[alertKey{severity, false}] = 0
+			counts[alertKey{severity, true}] = 0
+		}
+		pv.mu.Lock()
+		for _, content := range pv.alerts {
+			counts[alertKey{content.Severity, content.IsAcknowledged}]++
+		}
+		pv.mu.Unlock()
+
+		for k, v := range counts {
+			alertAttrs := metric.WithAttributes(
+				attribute.String("severity", k.severity.String()),
+				attribute.String("acknowledged", strconv.FormatBool(k.acknowledged)),
+			)
+			observer.ObserveFloat64(observableMap["open"], v, clientAttrs, alertAttrs)
+		}
+
+		return nil
+	}, observableArray...)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/Arinashin3/ari-agent/client/unisphere"
 	"github.com/Arinashin3/ari-agent/utils/provider"
 	"go.opentelemetry.io/otel/log"
 	sdkLog "go.opentelemetry.io/otel/sdk/log"
@@ -64,10 +65,8 @@ func (pv *eventProvider) Run() {
 			"message",
 			"source",
 		}
-		filters := []string{
-			"creationTime gt \"" + ctime.Format("2006-01-02T15:04:05.000Z") + "\"",
-		}
-		data, err := uc.GetEventInstances(fields, filters)
+		filter := unisphere.NewFilter().Gt("creationTime", ctime)
+		data, err := uc.GetEventInstances(fields, filter)
 		if err != nil {
 			logger.Error("Error to GET EventLog", "err", err)
 			time.Sleep(pv.interval)
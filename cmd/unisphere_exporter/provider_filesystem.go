@@ -0,0 +1,211 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Arinashin3/ari-agent/client/unisphere"
+	"github.com/Arinashin3/ari-agent/utils/provider"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkMetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+func init() {
+	moduleName := "filesystem"
+	registProvider(moduleName, &filesystemProvider{moduleName: moduleName})
+}
+
+func (pv *filesystemProvider) IsDefaultEnabled() bool {
+	return false
+}
+
+func (pv *filesystemProvider) NewProvider(moduleName string, cl *ClientDesc) Provider {
+	pvConf := cfg.Providers.Filesystem
+	enabled := pvConf.GetEnabled(pv.IsDefaultEnabled())
+	interval := pvConf.GetInterval()
+
+	if !enabled {
+		return nil
+	}
+	if MetricExporter == nil {
+		return nil
+	}
+	mp := provider.NewMeterProvider(serviceName, interval, MetricExporter)
+	return &filesystemProvider{
+		moduleName:    moduleName,
+		interval:      interval,
+		meterProvider: mp,
+		clientDesc:    cl,
+	}
+}
+
+type filesystemProvider struct {
+	moduleName    string
+	interval      time.Duration
+	meterProvider *sdkMetric.MeterProvider
+	clientDesc    *ClientDesc
+}
+
+// Health 값: 0: Unknown, 5: Ok, 7: OkBut, 10: Degraded, 15: Minor, 20: Major, 25: Critical, 30: NonRecoverable
+var filesystemMetricDescs = []*provider.MetricDescriptor{
+	{
+		Key:      "health",
+		Name:     "unisphere_filesystem_health",
+		Desc:     "Health of unisphere filesystem",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "sizeTotal",
+		Name:     "unisphere_filesystem_total_capacity",
+		Desc:     "Total capacity of unisphere filesystem",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "sizeUsed",
+		Name:     "unisphere_filesystem_used_capacity",
+		Desc:     "Used capacity of unisphere filesystem",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "sizeAllocated",
+		Name:     "unisphere_filesystem_allocated_capacity",
+		Desc:     "Capacity allocated from the pool for unisphere filesystem",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "sizePreallocated",
+		Name:     "unisphere_filesystem_preallocated_capacity",
+		Desc:     "Preallocated capacity of unisphere filesystem",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "dataReductionSizeSaved",
+		Name:     "unisphere_filesystem_data_reduction_saved",
+		Desc:     "Capacity saved by data reduction of unisphere filesystem",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "dataReductionPercent",
+		Name:     "unisphere_filesystem_data_reduction_percent",
+		Desc:     "Data reduction percentage of unisphere filesystem",
+		Unit:     "%",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "dataReductionRatio",
+		Name:     "unisphere_filesystem_data_reduction_ratio",
+		Desc:     "Data reduction ratio of unisphere filesystem",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "snapsSizeTotal",
+		Name:     "unisphere_filesystem_snapshot_total_capacity",
+		Desc:     "Total capacity of snapshots of unisphere filesystem",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "snapsSizeAllocated",
+		Name:     "unisphere_filesystem_snapshot_allocated_capacity",
+		Desc:     "Capacity allocated for snapshots (snapshot reserve) of unisphere filesystem",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "snapCount",
+		Name:     "unisphere_filesystem_snapshot_count",
+		Desc:     "Number of snapshots of unisphere filesystem",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+}
+
+func (pv *filesystemProvider) Run() {
+	logger.Info("Starting provider", "endpoint", pv.clientDesc.endpoint, "provider", pv.moduleName)
+	meter := pv.meterProvider.Meter(pv.moduleName)
+	uc := pv.clientDesc.client
+
+	// Register Metrics...
+	var observableMap map[string]metric.Float64Observable
+	observableMap = provider.CreateMapMetricDescriptor(meter, filesystemMetricDescs, logger)
+
+	// Register Metrics for Observables...
+	var observableArray []metric.Observable
+	for _, obserable := range observableMap {
+		observableArray = append(observableArray, obserable)
+	}
+
+	// Request Fields
+	var paramsFields = []string{
+		"name", "health", "sizeTotal", "sizeUsed", "sizeAllocated", "sizePreallocated",
+		"dataReductionSizeSaved", "dataReductionPercent", "dataReductionRatio",
+		"storageResource", "pool.name", "nasServer.name",
+	}
+
+	// Callback
+	meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+
+		// Client Attributes
+		if pv.clientDesc.hostLabels == nil {
+			return errors.New("hostLabels not set")
+		}
+		clientAttrs := metric.WithAttributes(pv.clientDesc.hostLabels...)
+
+		// Request Data
+		data, err := uc.GetFilesystemInstances(paramsFields, nil)
+		if err != nil {
+			logger.Error("Failed to get filesystem", "error", err)
+			return nil
+		}
+
+		// Snapshot 용량은 storageResource 에서 조회
+		snapData := make(map[string]unisphere.StorageResourceContent)
+		resData, err := uc.GetStorageResourceInstances([]string{"snapCount", "snapsSizeTotal", "snapsSizeAllocated"}, unisphere.NewFilter().Eq("type", unisphere.StorageResourceTypeFilesystem))
+		if err != nil {
+			logger.Error("Failed to get storageResource", "error", err)
+		} else {
+			for _, entry := range resData.Entries {
+				snapData[entry.Content.Id] = entry.Content
+			}
+		}
+
+		// Filesystem Attributes...
+		for _, entry := range data.Entries {
+			content := entry.Content
+			fsAttrs := metric.WithAttributes(
+				attribute.String("filesystem.name", content.Name),
+				attribute.String("filesystem.id", content.Id),
+				attribute.String("nas_server.name", content.NasServer.Name),
+				attribute.String("pool.name", content.Pool.Name),
+			)
+			observer.ObserveFloat64(observableMap["health"], float64(content.Health.Value), clientAttrs, fsAttrs)
+			observer.ObserveFloat64(observableMap["sizeTotal"], content.SizeTotal.ToMiB(), clientAttrs, fsAttrs)
+			observer.ObserveFloat64(observableMap["sizeUsed"], content.SizeUsed.ToMiB(), clientAttrs, fsAttrs)
+			observer.ObserveFloat64(observableMap["sizeAllocated"], content.SizeAllocated.ToMiB(), clientAttrs, fsAttrs)
+			observer.ObserveFloat64(observableMap["sizePreallocated"], content.SizePreallocated.ToMiB(), clientAttrs, fsAttrs)
+			observer.ObserveFloat64(observableMap["dataReductionSizeSaved"], content.DataReductionSizeSaved.ToMiB(), clientAttrs, fsAttrs)
+			observer.ObserveFloat64(observableMap["dataReductionPercent"], float64(content.DataReductionPercent), clientAttrs, fsAttrs)
+			observer.ObserveFloat64(observableMap["dataReductionRatio"], content.DataReductionRatio, clientAttrs, fsAttrs)
+
+			snap, ok := snapData[content.StorageResource.Id]
+			if !ok {
+				continue
+			}
+			observer.ObserveFloat64(observableMap["snapsSizeTotal"], snap.SnapsSizeTotal.ToMiB(), clientAttrs, fsAttrs)
+			observer.ObserveFloat64(observableMap["snapsSizeAllocated"], snap.SnapsSizeAllocated.ToMiB(), clientAttrs, fsAttrs)
+			observer.ObserveFloat64(observableMap["snapCount"], float64(snap.SnapCount), clientAttrs, fsAttrs)
+		}
+
+		return nil
+	}, observableArray...)
+
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/Arinashin3/ari-agent/client/unisphere"
+	"github.com/Arinashin3/ari-agent/utils/convert"
+	"github.com/Arinashin3/ari-agent/utils/provider"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkMetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+func init() {
+	moduleName := "hardware"
+	registProvider(moduleName, &hardwareProvider{moduleName: moduleName})
+}
+
+func (pv *hardwareProvider) IsDefaultEnabled() bool {
+	return true
+}
+
+func (pv *hardwareProvider) NewProvider(moduleName string, cl *ClientDesc) Provider {
+	pvConf := cfg.Providers.Hardware
+	enabled := pvConf.GetEnabled(pv.IsDefaultEnabled())
+	interval := pvConf.GetInterval()
+
+	if !enabled {
+		return nil
+	}
+	if MetricExporter == nil {
+		return nil
+	}
+	mp := provider.NewMeterProvider(serviceName, interval, MetricExporter)
+	return &hardwareProvider{
+		moduleName:    moduleName,
+		interval:      interval,
+		meterProvider: mp,
+		clientDesc:    cl,
+	}
+}
+
+type hardwareProvider struct {
+	moduleName    string
+	interval      time.Duration
+	meterProvider *sdkMetric.MeterProvider
+	clientDesc    *ClientDesc
+}
+
+// Health 값: 0: Unknown, 5: Ok, 7: OkBut, 10: Degraded, 15: Minor, 20: Major, 25: Critical, 30: NonRecoverable
+var hardwareMetricDescs = []*provider.MetricDescriptor{
+	{
+		Key:      "health",
+		Name:     "unisphere_hardware_health",
+		Desc:     "Health of unisphere hardware component",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "needsReplacement",
+		Name:     "unisphere_hardware_needs_replacement",
+		Desc:     "Whether unisphere hardware component needs to be replaced",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "currentTemperature",
+		Name:     "unisphere_hardware_temperature",
+		Desc:     "Current temperature of unisphere enclosure",
+		Unit:     "celsius",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "currentPower",
+		Name:     "unisphere_hardware_power",
+		Desc:     "Current power consumption of unisphere enclosure",
+		Unit:     "watt",
+		TypeName: "gauge",
+	},
+}
+
+func (pv *hardwareProvider) Run() {
+	logger.Info("Starting provider", "endpoint", pv.clientDesc.endpoint, "provider", pv.moduleName)
+	meter := pv.meterProvider.Meter(pv.moduleName)
+	uc := pv.clientDesc.client
+
+	// Register Metrics...
+	var observableMap map[string]metric.Float64Observable
+	observableMap = provider.CreateMapMetricDescriptor(meter, hardwareMetricDescs, logger)
+
+	// Register Metrics for Observables...
+	var observableArray []metric.Observable
+	for _, obserable := range observableMap {
+		observableArray = append(observableArray, obserable)
+	}
+
+	// Request Fields
+	enclosureFields := append([]string{"currentTemperature", "currentPower"}, unisphere.HardwareFields...)
+	componentFields := append([]string{"parentDae", "parentDpe"}, unisphere.HardwareFields...)
+	spComponentFields := append([]string{"parentStorageProcessor"}, unisphere.HardwareFields...)
+
+	// Callback
+	meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+
+		// Client Attributes
+		if pv.clientDesc.hostLabels == nil {
+			return errors.New("hostLabels not set")
+		}
+		clientAttrs := metric.WithAttributes(pv.clientDesc.hostLabels...)
+
+		observeHardware := func(componentType string, content *unisphere.HardwareContent) metric.MeasurementOption {
+			hwAttrs := metric.WithAttributes(
+				attribute.String("component.type", componentType),
+				attribute.String("component.id", content.Id),
+				attribute.String("component.name", content.Name),
+				attribute.String("slot.id", strconv.Itoa(content.SlotNumber)),
+				attribute.String("fru.part_number", content.EmcPartNumber),
+				attribute.String("serial", content.EmcSerialNumber),
+				attribute.String("parent", content.Parent()),
+			)
+			observer.ObserveFloat64(observableMap["health"], float64(content.Health.Value), clientAttrs, hwAttrs)
+			observer.ObserveFloat64(observableMap["needsReplacement"], convert.BoolToFloat64(content.NeedsReplacement), clientAttrs, hwAttrs)
+			return hwAttrs
+		}
+
+		// Request Data (DPE)
+		dpeData, err := uc.GetDpeInstances(enclosureFields, nil)
+		if err != nil {
+			logger.Error("Failed to get dpe", "error", err)
+		} else {
+			for _, entry := range dpeData.Entries {
+				content := entry.Content
+				hwAttrs := observeHardware("dpe", &content.HardwareContent)
+				observer.ObserveFloat64(observableMap["currentTemperature"], float64(content.CurrentTemperature), clientAttrs, hwAttrs)
+				observer.ObserveFloat64(observableMap["currentPower"], float64(content.CurrentPower), clientAttrs, hwAttrs)
+			}
+		}
+
+		// Request Data (DAE)
+		daeData, err := uc.GetDaeInstances(enclosureFields, nil)
+		if err != nil {
+			logger.Error("Failed to get dae", "error", err)
+		} else {
+			for _, entry := range daeData.Entries {
+				content := entry.Content
+				hwAttrs := observeHardware("dae", &content.HardwareContent)
+				observer.ObserveFloat64(observableMap["currentTemperature"], float64(content.CurrentTemperature), clientAttrs, hwAttrs)
+				observer.ObserveFloat64(observableMap["currentPower"], float64(content.CurrentPower), clientAttrs, hwAttrs)
+			}
+		}
+
+		// Request Data (Disk)
+		diskData, err := uc.GetDiskInstances(componentFields, nil)
+		if err != nil {
+			logger.Error("Failed to get disk", "error", err)
+		} else {
+			for _, entry := range diskData.Entries {
+				observeHardware("disk", &entry.Content.HardwareContent)
+			}
+		}
+
+		// Request Data (LCC, PowerSupply, Fan)
+		for componentType, getInstances := range map[string]func([]string, *unisphere.Filter) (*unisphere.Instances[unisphere.HardwareContent], error){
+			"lcc":         uc.GetLccInstances,
+			"powerSupply": uc.GetPowerSupplyInstances,
+			"fan":         uc.GetFanInstances,
+		} {
+			data, err := getInstances(componentFields, nil)
+			if err != nil {
+				logger.Error("Failed to get "+componentType, "error", err)
+				continue
+			}
+			for _, entry := range data.Entries {
+				observeHardware(componentType, &entry.Content)
+			}
+		}
+
+		// Request Data (Battery, SSD)
+		// SSD 의 wear level 은 API 에서 제공하지 않으므로 health, needsReplacement 만 전송
+		for componentType, getInstances := range map[string]func([]string, *unisphere.Filter) (*unisphere.Instances[unisphere.HardwareContent], error){
+			"battery": uc.GetBatteryInstances,
+			"ssd":     uc.GetSsdInstances,
+		} {
+			data, err := getInstances(spComponentFields, nil)
+			if err != nil {
+				logger.Error("Failed to get "+componentType, "error", err)
+				continue
+			}
+			for _, entry := range data.Entries {
+				observeHardware(componentType, &entry.Content)
+			}
+		}
+
+		return nil
+	}, observableArray...)
+
+}
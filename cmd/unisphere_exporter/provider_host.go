@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Arinashin3/ari-agent/client/unisphere"
+	"github.com/Arinashin3/ari-agent/utils/provider"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkMetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+func init() {
+	moduleName := "host"
+	registProvider(moduleName, &hostProvider{moduleName: moduleName})
+}
+
+func (pv *hostProvider) IsDefaultEnabled() bool {
+	return false
+}
+
+func (pv *hostProvider) NewProvider(moduleName string, cl *ClientDesc) Provider {
+	pvConf := cfg.Providers.Host
+	enabled := pvConf.GetEnabled(pv.IsDefaultEnabled())
+	interval := pvConf.GetInterval()
+
+	if !enabled {
+		return nil
+	}
+	if MetricExporter == nil {
+		return nil
+	}
+	mp := provider.NewMeterProvider(serviceName, interval, MetricExporter)
+	return &hostProvider{
+		moduleName:    moduleName,
+		interval:      interval,
+		meterProvider: mp,
+		clientDesc:    cl,
+	}
+}
+
+type hostProvider struct {
+	moduleName    string
+	interval      time.Duration
+	meterProvider *sdkMetric.MeterProvider
+	clientDesc    *ClientDesc
+}
+
+// Health 값: 0: Unknown, 5: Ok, 7: OkBut, 10: Degraded, 15: Minor, 20: Major, 25: Critical, 30: NonRecoverable
+var hostMetricDescs = []*provider.MetricDescriptor{
+	{
+		Key:      "health",
+		Name:     "unisphere_host_health",
+		Desc:     "Health of unisphere host",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "mappedLuns",
+		Name:     "unisphere_host_mapped_luns",
+		Desc:     "Number of luns mapped to unisphere host",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "mappedCapacity",
+		Name:     "unisphere_host_mapped_capacity",
+		Desc:     "Total capacity of luns mapped to unisphere host",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "initiatorCount",
+		Name:     "unisphere_host_initiator_count",
+		Desc:     "Number of initiators of unisphere host",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "initiator.health",
+		Name:     "unisphere_host_initiator_health",
+		Desc:     "Health of unisphere host initiator",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "initiator.paths",
+		Name:     "unisphere_host_initiator_paths",
+		Desc:     "Number of paths of unisphere host initiator",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+}
+
+func (pv *hostProvider) Run() {
+	logger.Info("Starting provider", "endpoint", pv.clientDesc.endpoint, "provider", pv.moduleName)
+	meter := pv.meterProvider.Meter(pv.moduleName)
+	uc := pv.clientDesc.client
+
+	// Register Metrics...
+	var observableMap map[string]metric.Float64Observable
+	observableMap = provider.CreateMapMetricDescriptor(meter, hostMetricDescs, logger)
+
+	// Register Metrics for Observables...
+	var observableArray []metric.Observable
+	for _, obserable := range observableMap {
+		observableArray = append(observableArray, obserable)
+	}
+
+	// Callback
+	meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+
+		// Client Attributes
+		if pv.clientDesc.hostLabels == nil {
+			return errors.New("hostLabels not set")
+		}
+		clientAttrs := metric.WithAttributes(pv.clientDesc.hostLabels...)
+
+		// Request Data
+		data, err := uc.GetHostInstances([]string{"name", "health", "type", "osType", "hostContainer"}, nil)
+		if err != nil {
+			logger.Error("Failed to get host", "error", err)
+			return nil
+		}
+
+		// Host Groups (hostContainer)
+		groupNames := make(map[string]string)
+		groupData, err := uc.GetHostContainerInstances([]string{"name"}, nil)
+		if err != nil {
+			logger.Error("Failed to get hostContainer", "error", err)
+		} else {
+			for _, entry := range groupData.Entries {
+				groupNames[entry.Content.Id] = entry.Content.Name
+			}
+		}
+
+		// Lun Capacity
+		// 조회에 실패한 항목은 0 으로 보이지 않도록 전송하지 않음
+		lunCapacity := make(map[string]float64)
+		lunData, err := uc.GetLunInstances([]string{"sizeTotal"}, nil)
+		lunOk := err == nil
+		if err != nil {
+			logger.Error("Failed to get lun", "error", err)
+		} else {
+			for _, entry := range lunData.Entries {
+				lunCapacity[entry.Content.Id] = entry.Content.SizeTotal.ToMiB()
+			}
+		}
+
+		// Host Mappings (snapshot 매핑은 제외)
+		mappedLuns := make(map[string]float64)
+		mappedCapacity := make(map[string]float64)
+		hostLunData, err := uc.GetHostLUNInstances([]string{"host", "lun", "type"}, unisphere.NewFilter().Eq("type", unisphere.HostLUNTypeLUN))
+		hostLunOk := err == nil
+		if err != nil {
+			logger.Error("Failed to get hostLUN", "error", err)
+		} else {
+			for _, entry := range hostLunData.Entries {
+				content := entry.Content
+				mappedLuns[content.Host.Id]++
+				mappedCapacity[content.Host.Id] += lunCapacity[content.Lun.Id]
+			}
+		}
+
+		hostNames := make(map[string]string)
+		for _, entry := range data.Entries {
+			content := entry.Content
+			hostNames[content.Id] = content.Name
+		}
+
+		// Host Initiators
+		initiatorCount := make(map[string]float64)
+		initiatorData, err := uc.GetHostInitiatorInstances([]string{"health", "type", "initiatorId", "parentHost", "isIgnored", "paths"}, nil)
+		initiatorOk := err == nil
+		if err != nil {
+			logger.Error("Failed to get hostInitiator", "error", err)
+		} else {
+			for _, entry := range initiatorData.Entries {
+				content := entry.Content
+				if content.IsIgnored {
+					continue
+				}
+				initiatorCount[content.ParentHost.Id]++
+				initiatorAttrs := metric.WithAttributes(
+					attribute.String("unity_host.name", hostNames[content.ParentHost.Id]),
+					attribute.String("initiator.id", content.InitiatorId),
+					attribute.String("initiator.type", content.Type.String()),
+				)
+				observer.ObserveFloat64(observableMap["initiator.health"], float64(content.Health.Value), clientAttrs, initiatorAttrs)
+				observer.ObserveFloat64(observableMap["initiator.paths"], float64(len(content.Paths)), clientAttrs, initiatorAttrs)
+			}
+		}
+
+		// Host Attributes...
+		for _, entry := range data.Entries {
+			content := entry.Content
+			hostAttrs := metric.WithAttributes(
+				attribute.String("unity_host.name", content.Name),
+				attribute.String("unity_host.id", content.Id),
+				attribute.String("unity_host.os", content.OsType),
+				attribute.String("unity_host.group", groupNames[content.HostContainer.Id]),
+			)
+			observer.ObserveFloat64(observableMap["health"], float64(content.Health.Value), clientAttrs, hostAttrs)
+			if hostLunOk {
+				observer.ObserveFloat64(observableMap["mappedLuns"], mappedLuns[content.Id], clientAttrs, hostAttrs)
+			}
+			if hostLunOk && lunOk {
+				observer.ObserveFloat64(observableMap["mappedCapacity"], mappedCapacity[content.Id], clientAttrs, hostAttrs)
+			}
+			if initiatorOk {
+				observer.ObserveFloat64(observableMap["initiatorCount"], initiatorCount[content.Id], clientAttrs, hostAttrs)
+			}
+		}
+
+		return nil
+	}, observableArray...)
+
+}
@@ -3,11 +3,13 @@ package main
 import (
 	"context"
 	"errors"
-	"reflect"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/Arinashin3/ari-agent/client/unisphere"
 	"github.com/Arinashin3/ari-agent/config/cfgUnisphere"
 	"github.com/Arinashin3/ari-agent/utils/provider"
 	"go.opentelemetry.io/otel/attribute"
@@ -16,25 +18,38 @@ import (
 )
 
 func init() {
-	registProvider("metric_a", &metricProvider{moduleName: "metric_a"})
-	registProvider("metric_b", &metricProvider{moduleName: "metric_b"})
-	registProvider("metric_c", &metricProvider{moduleName: "metric_c"})
+	moduleName := "metrics"
+	registProvider(moduleName, &metricProvider{moduleName: moduleName})
 }
 
 func (pv *metricProvider) IsDefaultEnabled() bool {
 	return false
 }
 
+// NewProvider
+// providers.metrics 에 정의된 그룹마다 metricProvider 를 생성합니다.
+// 각 그룹의 이름이 moduleName 으로 사용됩니다.
 func (pv *metricProvider) NewProvider(moduleName string, cl *ClientDesc) Provider {
-	var pvConf *cfgUnisphere.UnisphereProviderMetric
-	switch moduleName {
-	case "metric_a":
-		pvConf = cfg.Providers.Metric_A
-	case "metric_b":
-		pvConf = cfg.Providers.Metric_B
-	case "metric_c":
-		pvConf = cfg.Providers.Metric_C
+	var names []string
+	for name := range cfg.Providers.Metrics {
+		names = append(names, name)
 	}
+	sort.Strings(names)
+
+	var groups metricGroupProvider
+	for _, name := range names {
+		group := pv.newGroupProvider(name, cfg.Providers.Metrics[name], cl)
+		if group != nil {
+			groups = append(groups, group)
+		}
+	}
+	if len(groups) == 0 {
+		return nil
+	}
+	return groups
+}
+
+func (pv *metricProvider) newGroupProvider(moduleName string, pvConf *cfgUnisphere.UnisphereProviderMetric, cl *ClientDesc) *metricProvider {
 	if pvConf == nil {
 		return nil
 	}
@@ -51,14 +66,44 @@ func (pv *metricProvider) NewProvider(moduleName string, cl *ClientDesc) Provide
 	if MetricExporter == nil {
 		return nil
 	}
-	mp := provider.NewMeterProvider(serviceName, interval, MetricExporter)
-	return &metricProvider{
-		moduleName:    moduleName,
-		queryId:       "",
-		interval:      interval,
-		paths:         pvConf.Paths,
-		meterProvider: mp,
-		clientDesc:    cl,
+	newPv := &metricProvider{
+		moduleName: moduleName,
+		queryId:    "",
+		interval:   interval,
+		paths:      pvConf.Paths,
+		backfill:   pvConf.GetBackfill(),
+		clientDesc: cl,
+	}
+	exp := MetricExporter
+	if newPv.backfill > 0 {
+		// 전송 성공 여부로 수집이 끊긴 구간을 판단
+		var syncExp sdkMetric.Exporter = &syncExporter{Exporter: *MetricExporter, onExport: newPv.markSynced}
+		exp = &syncExp
+		backfillExp, err := getBackfillExporter()
+		if err != nil {
+			logger.Error("Failed to create backfill exporter, backfill is disabled", "provider", moduleName, "error", err)
+			newPv.backfill = 0
+			exp = MetricExporter
+		} else {
+			newPv.backfillExporter = backfillExp
+		}
+	}
+	newPv.meterProvider = provider.NewMeterProvider(serviceName, interval, exp)
+	newPv.startTime = time.Now()
+	return newPv
+}
+
+// metricGroupProvider
+// metric 그룹들을 하나의 Provider 로 묶어 실행합니다.
+type metricGroupProvider []*metricProvider
+
+func (groups metricGroupProvider) NewProvider(moduleName string, cl *ClientDesc) Provider {
+	return nil
+}
+
+func (groups metricGroupProvider) Run() {
+	for _, group := range groups {
+		go group.Run()
 	}
 }
 
@@ -69,6 +114,22 @@ type metricProvider struct {
 	paths         []string
 	meterProvider *sdkMetric.MeterProvider
 	clientDesc    *ClientDesc
+
+	// backfill
+	backfill         time.Duration
+	backfillExporter sdkMetric.Exporter
+	descs            map[string]*provider.MetricDescriptor
+	historicalPaths  []string
+	startTime        time.Time // counter 누적 시작 시간
+	mu               sync.Mutex
+	collected        time.Time                   // 마지막으로 실시간 데이터를 수집한 시간
+	synced           time.Time                   // 전송에 성공한 마지막 실시간 데이터의 수집 시간
+	observed         map[seriesKey]counterSample // 마지막으로 수집한 counter 값
+	anchors          map[seriesKey]counterSample // 전송에 성공한 counter 값
+	gapStart         time.Time                   // backfill 대기 중인 구간 (zero: 없음)
+	gapEnd           time.Time
+	gapAnchors       map[seriesKey]counterSample // gapStart 시점의 counter 값
+	backfilling      bool
 }
 
 func (pv *metricProvider) Run() {
@@ -77,10 +138,8 @@ func (pv *metricProvider) Run() {
 	uc := pv.clientDesc.client
 
 	// Get Metric Descriptions from Unisphere API...
-	filters := []string{
-		"isRealtimeAvailable eq true",
-	}
-	metricData, err := uc.GetMetricInstances([]string{"name", "path", "type", "unitDisplayString", "description"}, filters)
+	filter := unisphere.NewFilter().Eq("isRealtimeAvailable", true)
+	metricData, err := uc.GetMetricInstances([]string{"name", "path", "type", "unitDisplayString", "description", "isHistoricalAvailable"}, filter)
 	if err != nil {
 		logger.Error("Failed to get metric instances", "provider", pv.moduleName, "error", err)
 		return
@@ -124,6 +183,9 @@ func (pv *metricProvider) Run() {
 				tmp := "unisphere_" + strings.Replace(strings.ToLower(content.Path), ".*.", "_", -1)
 
 				metricPaths = append(metricPaths, content.Path)
+				if content.IsHistoricalAvailable {
+					pv.historicalPaths = append(pv.historicalPaths, content.Path)
+				}
 				metricDescList = append(metricDescList, &provider.MetricDescriptor{
 					Key:      content.Path,
 					Name:     strings.Replace(tmp, ".", "_", -1),
@@ -135,6 +197,10 @@ func (pv *metricProvider) Run() {
 		}
 	}
 
+	pv.descs = make(map[string]*provider.MetricDescriptor)
+	for _, md := range metricDescList {
+		pv.descs[md.Key] = md
+	}
 	// Register Metrics...
 	var observableMap map[string]metric.Float64Observable
 	observableMap = provider.CreateMapMetricDescriptor(meter, metricDescList, logger)
@@ -155,8 +221,9 @@ func (pv *metricProvider) Run() {
 	queryResult, err := uc.PostMetricRealTimeQueryInstances(metricPaths, pv.interval)
 	if err != nil {
 		logger.Error("Failed to post metric query", "provider", pv.moduleName, "error", err)
+	} else {
+		pv.queryId = strconv.Itoa(queryResult.Content.Id)
 	}
-	pv.queryId = strconv.Itoa(queryResult.Content.Id)
 
 	// Callback
 	meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
@@ -165,7 +232,9 @@ func (pv *metricProvider) Run() {
 			queryResult, err = uc.PostMetricRealTimeQueryInstances(metricPaths, pv.interval)
 			if err != nil {
 				logger.Error("Failed to post metric query", "provider", pv.moduleName, "error", err)
+				return nil
 			}
+			pv.queryId = strconv.Itoa(queryResult.Content.Id)
 		}
 
 		// Client Attributes
@@ -182,61 +251,72 @@ func (pv *metricProvider) Run() {
 			return nil
 		}
 
+		now := time.Now()
+		observed := make(map[seriesKey]counterSample)
+
 		// Metric Attributes...
 		for _, entry := range data.Entries {
 			content := entry.Content
-
-			// Create Label Name
-			var labels []string
-			var preString string
-			for _, v := range strings.Split(content.Path, ".") {
-				if v == "*" {
-					labels = append(labels, preString)
-				}
-				preString = v
-			}
-
-			// Get Metric
-			for k1, v1 := range content.Values.(map[string]interface{}) {
-				if reflect.TypeOf(v1).Kind().String() != "map" {
-					var f float64
-					f, err = strconv.ParseFloat(v1.(string), 64)
-					if err != nil {
-						logger.Error("Failed to parse metric value", "provider", pv.moduleName, "path", content.Path, "error", err)
-						continue
-					}
-					observer.ObserveFloat64(observableMap[content.Path], f, clientAttrs, metric.WithAttributes(attribute.String(labels[0], k1)))
-					continue
-				}
-				for k2, v2 := range v1.(map[string]interface{}) {
-					if reflect.TypeOf(v2).Kind().String() != "map" {
-						var f float64
-						f, err = strconv.ParseFloat(v2.(string), 64)
-						if err != nil {
-							logger.Error("Failed to parse metric value", "provider", pv.moduleName, "path", content.Path, "error", err)
-							continue
-						}
-						observer.ObserveFloat64(observableMap[content.Path], f, clientAttrs, metric.WithAttributes(attribute.String(labels[0], k1), attribute.String(labels[1], k2)))
-						continue
-					}
-					for k3, v3 := range v2.(map[string]interface{}) {
-						if reflect.TypeOf(v3).Kind().String() != "map" {
-							var f float64
-							f, err = strconv.ParseFloat(v3.(string), 64)
-							if err != nil {
-								logger.Error("Failed to parse metric value", "provider", pv.moduleName, "path", content.Path, "error", err)
-								continue
-							}
-							observer.ObserveFloat64(observableMap[content.Path], f, clientAttrs, metric.WithAttributes(attribute.String(labels[0], k1), attribute.String(labels[1], k2), attribute.String(labels[2], k3)))
-							continue
-						}
-					}
+			isCounter := pv.backfill > 0 && pv.descs[content.Path] != nil && pv.descs[content.Path].TypeName == "counter"
+			pv.walkMetricValues(content.Path, content.Values, func(f float64, attrs []attribute.KeyValue) {
+				observer.ObserveFloat64(observableMap[content.Path], f, clientAttrs, metric.WithAttributes(attrs...))
+				if isCounter {
+					var kvs []attribute.KeyValue
+					kvs = append(kvs, pv.clientDesc.hostLabels...)
+					kvs = append(kvs, attrs...)
+					set := attribute.NewSet(kvs...)
+					observed[seriesKey{path: content.Path, attrs: set.Equivalent()}] = counterSample{value: f, time: now}
 				}
+			})
+		}
 
-			}
+		if pv.backfill > 0 {
+			pv.mu.Lock()
+			pv.collected = now
+			pv.observed = observed
+			pv.mu.Unlock()
 		}
 
 		return nil
 	}, observableArray...)
 
 }
+
+// walkMetricValues
+// path 의 "*" 앞 항목 이름을 label 로 사용하여, 중첩된 values 의 각 값을 fn 으로 전달합니다.
+// ex) path: sp.*.physical.disk.*.reads => labels: sp, disk
+func (pv *metricProvider) walkMetricValues(path string, values interface{}, fn func(f float64, attrs []attribute.KeyValue)) {
+	var labels []string
+	var preString string
+	for _, v := range strings.Split(path, ".") {
+		if v == "*" {
+			labels = append(labels, preString)
+		}
+		preString = v
+	}
+
+	var walk func(values interface{}, attrs []attribute.KeyValue)
+	walk = func(values interface{}, attrs []attribute.KeyValue) {
+		m, ok := values.(map[string]interface{})
+		if !ok || len(attrs) >= len(labels) {
+			return
+		}
+		for k, v := range m {
+			kvs := append(attrs[:len(attrs):len(attrs)], attribute.String(labels[len(attrs)], k))
+			switch value := v.(type) {
+			case map[string]interface{}:
+				walk(value, kvs)
+			case float64:
+				fn(value, kvs)
+			case string:
+				f, err := strconv.ParseFloat(value, 64)
+				if err != nil {
+					logger.Error("Failed to parse metric value", "provider", pv.moduleName, "path", path, "error", err)
+					continue
+				}
+				fn(f, kvs)
+			}
+		}
+	}
+	walk(values, nil)
+}
@@ -0,0 +1,254 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Arinashin3/ari-agent/client/unisphere"
+	"github.com/Arinashin3/ari-agent/utils/provider"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdkMetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// syncExporter
+// 전송에 성공할 때마다 onExport 를 호출하는 Exporter 입니다.
+type syncExporter struct {
+	sdkMetric.Exporter
+	onExport func()
+}
+
+func (e *syncExporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	err := e.Exporter.Export(ctx, rm)
+	if err == nil {
+		e.onExport()
+	}
+	return err
+}
+
+// counterSample
+// counter 의 series 별 마지막 값과 그 수집 시간입니다.
+// backfill 시 이력 값을 이 값에 누적하여 실시간 counter 와 이어지도록 합니다.
+type counterSample struct {
+	value float64
+	time  time.Time
+}
+
+type seriesKey struct {
+	path  string
+	attrs attribute.Distinct
+}
+
+var (
+	backfillExporter     sdkMetric.Exporter
+	backfillExporterErr  error
+	backfillExporterOnce sync.Once
+)
+
+// getBackfillExporter
+// backfill 데이터는 MeterProvider 의 reader 를 거치지 않고 직접 전송하므로,
+// reader 가 사용하는 MetricExporter 와 별도로 모든 그룹이 공유하는 Exporter 를 하나 생성합니다.
+func getBackfillExporter() (sdkMetric.Exporter, error) {
+	backfillExporterOnce.Do(func() {
+		endpoint := cfg.Server.Metrics.Endpoint + cfg.Server.Metrics.Api_Path
+		mode := cfg.Server.Metrics.Mode
+		insecure, _ := strconv.ParseBool(cfg.Server.Metrics.Insecure)
+		exp, err := provider.NewMetricExporter(context.Background(), mode, endpoint, insecure)
+		if err != nil {
+			backfillExporterErr = err
+			return
+		}
+		backfillExporter = *exp
+	})
+	return backfillExporter, backfillExporterErr
+}
+
+// markSynced
+// 실시간 데이터가 전송된 시점에 호출되며,
+// 이전 전송과의 간격이 수집 주기의 2배를 넘으면 그 구간을 backfill 합니다.
+// agent 시작 후 첫 전송에서는 중지되어 있던 구간을 알 수 없으므로 backfill 기간 전체를 채웁니다.
+func (pv *metricProvider) markSynced() {
+	pv.mu.Lock()
+	defer pv.mu.Unlock()
+
+	if pv.synced.IsZero() {
+		pv.gapStart = pv.collected.Add(-pv.backfill)
+		pv.gapEnd = pv.collected
+		pv.gapAnchors = nil
+	} else {
+		if !pv.collected.After(pv.synced) {
+			return
+		}
+		if pv.collected.Sub(pv.synced) > 2*pv.interval {
+			if pv.gapStart.IsZero() || pv.synced.Before(pv.gapStart) {
+				pv.gapStart = pv.synced
+				pv.gapAnchors = pv.anchors
+			}
+			if pv.collected.After(pv.gapEnd) {
+				pv.gapEnd = pv.collected
+			}
+		}
+	}
+	pv.synced = pv.collected
+
+	// 전송된 counter 값을 다음 backfill 의 기준 값으로 사용
+	anchors := make(map[seriesKey]counterSample, len(pv.anchors))
+	for k, v := range pv.anchors {
+		anchors[k] = v
+	}
+	for k, v := range pv.observed {
+		anchors[k] = v
+	}
+	pv.anchors = anchors
+
+	if pv.gapStart.IsZero() || pv.backfilling {
+		return
+	}
+	// backfill 기간을 넘은 구간은 버림
+	limit := pv.collected.Add(-pv.backfill)
+	if !pv.gapEnd.After(limit) {
+		pv.gapStart = time.Time{}
+		pv.gapEnd = time.Time{}
+		pv.gapAnchors = nil
+		return
+	}
+	if pv.gapStart.Before(limit) {
+		pv.gapStart = limit
+		pv.gapAnchors = nil
+	}
+	pv.backfilling = true
+	go pv.runBackfill(pv.gapStart, pv.gapEnd, pv.gapAnchors)
+}
+
+func (pv *metricProvider) runBackfill(from time.Time, to time.Time, anchors map[seriesKey]counterSample) {
+	logger.Info("Backfill metrics", "provider", pv.moduleName, "endpoint", pv.clientDesc.endpoint, "from", from, "to", to)
+	err := pv.exportHistorical(from, to, anchors)
+
+	pv.mu.Lock()
+	defer pv.mu.Unlock()
+	pv.backfilling = false
+	if err != nil {
+		// 실패한 구간은 다음 전송 성공 시 다시 시도
+		logger.Error("Failed to backfill metrics", "provider", pv.moduleName, "endpoint", pv.clientDesc.endpoint, "error", err)
+		return
+	}
+	if pv.gapEnd.After(to) {
+		// backfill 중에 새로 끊긴 구간은 기준 값을 알 수 없으므로 counter 는 제외
+		pv.gapStart = to
+		pv.gapAnchors = nil
+		return
+	}
+	pv.gapStart = time.Time{}
+	pv.gapEnd = time.Time{}
+	pv.gapAnchors = nil
+}
+
+// exportHistorical
+// from ~ to 구간의 이력(metricValue) 샘플을 실시간 데이터와 같은 이름, 같은 종류로 원래의 timestamp 와 함께 전송합니다.
+// 5분 샘플은 보관 기간이 짧으므로, 하루가 지난 구간은 1시간 샘플을 사용합니다.
+// counter 의 이력 값은 초당 값이므로, 구간 시작 시점의 counter 값(anchors)에 interval 만큼 누적하여 전송하며,
+// 기준 값이 없는 series (agent 시작 직후 등)는 누적 값을 만들 수 없으므로 전송하지 않습니다.
+func (pv *metricProvider) exportHistorical(from time.Time, to time.Time, anchors map[seriesKey]counterSample) error {
+	if pv.clientDesc.hostLabels == nil {
+		return errors.New("hostLabels not set")
+	}
+	uc := pv.clientDesc.client
+	ctx := context.Background()
+
+	interval := 300
+	if time.Since(from) > 24*time.Hour {
+		interval = 3600
+	}
+
+	for _, path := range pv.historicalPaths {
+		md := pv.descs[path]
+		isCounter := md.TypeName == "counter"
+		if isCounter && len(anchors) == 0 {
+			continue
+		}
+		filter := unisphere.NewFilter().Eq("path", path).Eq("interval", interval).Gt("timestamp", from).Lt("timestamp", to)
+		data, err := uc.GetMetricValueInstances([]string{"path", "timestamp", "interval", "values"}, filter)
+		if err != nil {
+			return err
+		}
+		// counter 는 시간 순서대로 누적
+		sort.Slice(data.Entries, func(i, j int) bool {
+			return data.Entries[i].Content.Timestamp.Before(data.Entries[j].Content.Timestamp)
+		})
+
+		sums := make(map[seriesKey]counterSample)
+		var dataPoints []metricdata.DataPoint[float64]
+		for _, entry := range data.Entries {
+			content := entry.Content
+			pv.walkMetricValues(path, content.Values, func(f float64, attrs []attribute.KeyValue) {
+				var kvs []attribute.KeyValue
+				kvs = append(kvs, pv.clientDesc.hostLabels...)
+				kvs = append(kvs, attrs...)
+				set := attribute.NewSet(kvs...)
+				var startTime time.Time
+				if isCounter {
+					key := seriesKey{path: path, attrs: set.Equivalent()}
+					sum, ok := sums[key]
+					if !ok {
+						sum, ok = anchors[key]
+					}
+					if !ok || !content.Timestamp.After(sum.time) {
+						return
+					}
+					sum.value += f * float64(content.Interval)
+					sum.time = content.Timestamp
+					sums[key] = sum
+					f = sum.value
+					startTime = pv.startTime
+				}
+				dataPoints = append(dataPoints, metricdata.DataPoint[float64]{
+					Attributes: set,
+					StartTime:  startTime,
+					Time:       content.Timestamp,
+					Value:      f,
+				})
+			})
+		}
+		if len(dataPoints) == 0 {
+			continue
+		}
+
+		var agg metricdata.Aggregation = metricdata.Gauge[float64]{
+			DataPoints: dataPoints,
+		}
+		if isCounter {
+			agg = metricdata.Sum[float64]{
+				DataPoints:  dataPoints,
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+			}
+		}
+		rm := &metricdata.ResourceMetrics{
+			Resource: provider.NewResource(serviceName),
+			ScopeMetrics: []metricdata.ScopeMetrics{
+				{
+					Scope: instrumentation.Scope{Name: pv.moduleName},
+					Metrics: []metricdata.Metrics{
+						{
+							Name:        md.Name,
+							Description: md.Desc,
+							Unit:        md.Unit,
+							Data:        agg,
+						},
+					},
+				},
+			},
+		}
+		err = pv.backfillExporter.Export(ctx, rm)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,267 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Arinashin3/ari-agent/utils/convert"
+	"github.com/Arinashin3/ari-agent/utils/provider"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkMetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+func init() {
+	moduleName := "nas"
+	registProvider(moduleName, &nasProvider{moduleName: moduleName})
+}
+
+func (pv *nasProvider) IsDefaultEnabled() bool {
+	return false
+}
+
+func (pv *nasProvider) NewProvider(moduleName string, cl *ClientDesc) Provider {
+	pvConf := cfg.Providers.Nas
+	enabled := pvConf.GetEnabled(pv.IsDefaultEnabled())
+	interval := pvConf.GetInterval()
+
+	if !enabled {
+		return nil
+	}
+	if MetricExporter == nil {
+		return nil
+	}
+	mp := provider.NewMeterProvider(serviceName, interval, MetricExporter)
+	return &nasProvider{
+		moduleName:    moduleName,
+		interval:      interval,
+		meterProvider: mp,
+		clientDesc:    cl,
+	}
+}
+
+type nasProvider struct {
+	moduleName    string
+	interval      time.Duration
+	meterProvider *sdkMetric.MeterProvider
+	clientDesc    *ClientDesc
+}
+
+// Health 값: 0: Unknown, 5: Ok, 7: OkBut, 10: Degraded, 15: Minor, 20: Major, 25: Critical, 30: NonRecoverable
+var nasMetricDescs = []*provider.MetricDescriptor{
+	{
+		Key:      "health",
+		Name:     "unisphere_nas_server_health",
+		Desc:     "Health of unisphere NAS server",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "failedOver",
+		Name:     "unisphere_nas_server_failed_over",
+		Desc:     "Whether unisphere NAS server is running on a SP other than its home SP",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "isReplicationDestination",
+		Name:     "unisphere_nas_server_replication_destination",
+		Desc:     "Whether unisphere NAS server is a replication destination",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "sizeAllocated",
+		Name:     "unisphere_nas_server_allocated_capacity",
+		Desc:     "Capacity allocated for configuration of unisphere NAS server",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "nfsShareCount",
+		Name:     "unisphere_nas_server_nfs_share_count",
+		Desc:     "Number of NFS shares of unisphere NAS server",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "cifsShareCount",
+		Name:     "unisphere_nas_server_cifs_share_count",
+		Desc:     "Number of SMB shares of unisphere NAS server",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "cifsServer.health",
+		Name:     "unisphere_cifs_server_health",
+		Desc:     "Health of unisphere SMB server",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "nfsShare.info",
+		Name:     "unisphere_nfs_share_info",
+		Desc:     "Information about unisphere NFS share",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "cifsShare.info",
+		Name:     "unisphere_cifs_share_info",
+		Desc:     "Information about unisphere SMB share",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+}
+
+func (pv *nasProvider) Run() {
+	logger.Info("Starting provider", "endpoint", pv.clientDesc.endpoint, "provider", pv.moduleName)
+	meter := pv.meterProvider.Meter(pv.moduleName)
+	uc := pv.clientDesc.client
+
+	// Register Metrics...
+	var observableMap map[string]metric.Float64Observable
+	observableMap = provider.CreateMapMetricDescriptor(meter, nasMetricDescs, logger)
+
+	// Register Metrics for Observables...
+	var observableArray []metric.Observable
+	for _, obserable := range observableMap {
+		observableArray = append(observableArray, obserable)
+	}
+
+	// Request Fields
+	var paramsFields = []string{
+		"name", "health", "homeSP", "currentSP", "sizeAllocated",
+		"isReplicationEnabled", "isReplicationDestination", "replicationType",
+	}
+
+	// Callback
+	meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+
+		// Client Attributes
+		if pv.clientDesc.hostLabels == nil {
+			return errors.New("hostLabels not set")
+		}
+		clientAttrs := metric.WithAttributes(pv.clientDesc.hostLabels...)
+
+		// Request Data
+		data, err := uc.GetNasServerInstances(paramsFields, nil)
+		if err != nil {
+			logger.Error("Failed to get nasServer", "error", err)
+			return nil
+		}
+
+		// Share 는 filesystem 을 통해 NAS server 와 연결
+		// 조회에 실패한 경우 share 개수를 알 수 없으므로 개수 gauge 는 생략
+		fsNasServer := make(map[string]string)
+		fsOk := false
+		fsData, err := uc.GetFilesystemInstances([]string{"name", "nasServer"}, nil)
+		if err != nil {
+			logger.Error("Failed to get filesystem", "error", err)
+		} else {
+			fsOk = true
+			for _, entry := range fsData.Entries {
+				fsNasServer[entry.Content.Id] = entry.Content.NasServer.Id
+			}
+		}
+
+		nasNames := make(map[string]string)
+		for _, entry := range data.Entries {
+			nasNames[entry.Content.Id] = entry.Content.Name
+		}
+
+		// NFS Shares
+		nfsShareCount := make(map[string]float64)
+		nfsOk := false
+		nfsData, err := uc.GetNfsShareInstances([]string{"name", "path", "exportPaths", "isReadOnly", "filesystem.name"}, nil)
+		if err != nil {
+			logger.Error("Failed to get nfsShare", "error", err)
+		} else {
+			nfsOk = fsOk
+			for _, entry := range nfsData.Entries {
+				content := entry.Content
+				nasId := fsNasServer[content.Filesystem.Id]
+				nfsShareCount[nasId]++
+				for _, exportPath := range content.ExportPaths {
+					shareAttrs := metric.WithAttributes(
+						attribute.String("share.name", content.Name),
+						attribute.String("share.path", content.Path),
+						attribute.String("export.path", exportPath),
+						attribute.String("filesystem.name", content.Filesystem.Name),
+						attribute.String("nas_server.name", nasNames[nasId]),
+					)
+					observer.ObserveFloat64(observableMap["nfsShare.info"], 1, clientAttrs, shareAttrs)
+				}
+			}
+		}
+
+		// SMB Shares
+		cifsShareCount := make(map[string]float64)
+		cifsOk := false
+		cifsData, err := uc.GetCifsShareInstances([]string{"name", "path", "exportPaths", "isReadOnly", "filesystem.name"}, nil)
+		if err != nil {
+			logger.Error("Failed to get cifsShare", "error", err)
+		} else {
+			cifsOk = fsOk
+			for _, entry := range cifsData.Entries {
+				content := entry.Content
+				nasId := fsNasServer[content.Filesystem.Id]
+				cifsShareCount[nasId]++
+				for _, exportPath := range content.ExportPaths {
+					shareAttrs := metric.WithAttributes(
+						attribute.String("share.name", content.Name),
+						attribute.String("share.path", content.Path),
+						attribute.String("export.path", exportPath),
+						attribute.String("filesystem.name", content.Filesystem.Name),
+						attribute.String("nas_server.name", nasNames[nasId]),
+					)
+					observer.ObserveFloat64(observableMap["cifsShare.info"], 1, clientAttrs, shareAttrs)
+				}
+			}
+		}
+
+		// SMB Servers
+		cifsServerData, err := uc.GetCifsServerInstances([]string{"name", "health", "netbiosName", "domain", "nasServer"}, nil)
+		if err != nil {
+			logger.Error("Failed to get cifsServer", "error", err)
+		} else {
+			for _, entry := range cifsServerData.Entries {
+				content := entry.Content
+				serverAttrs := metric.WithAttributes(
+					attribute.String("cifs_server.name", content.Name),
+					attribute.String("cifs_server.netbios_name", content.NetbiosName),
+					attribute.String("cifs_server.domain", content.Domain),
+					attribute.String("nas_server.name", nasNames[content.NasServer.Id]),
+				)
+				observer.ObserveFloat64(observableMap["cifsServer.health"], float64(content.Health.Value), clientAttrs, serverAttrs)
+			}
+		}
+
+		// NAS Server Attributes...
+		for _, entry := range data.Entries {
+			content := entry.Content
+			nasAttrs := metric.WithAttributes(
+				attribute.String("nas_server.name", content.Name),
+				attribute.String("nas_server.id", content.Id),
+				attribute.String("sp.home", content.HomeSP.Id),
+				attribute.String("sp.current", content.CurrentSP.Id),
+				attribute.String("replication.role", content.ReplicationRole()),
+				attribute.String("replication.type", content.ReplicationType.String()),
+			)
+			observer.ObserveFloat64(observableMap["health"], float64(content.Health.Value), clientAttrs, nasAttrs)
+			observer.ObserveFloat64(observableMap["failedOver"], convert.BoolToFloat64(content.CurrentSP.Id != content.HomeSP.Id), clientAttrs, nasAttrs)
+			observer.ObserveFloat64(observableMap["isReplicationDestination"], convert.BoolToFloat64(content.IsReplicationDestination), clientAttrs, nasAttrs)
+			observer.ObserveFloat64(observableMap["sizeAllocated"], content.SizeAllocated.ToMiB(), clientAttrs, nasAttrs)
+			if nfsOk {
+				observer.ObserveFloat64(observableMap["nfsShareCount"], nfsShareCount[content.Id], clientAttrs, nasAttrs)
+			}
+			if cifsOk {
+				observer.ObserveFloat64(observableMap["cifsShareCount"], cifsShareCount[content.Id], clientAttrs, nasAttrs)
+			}
+		}
+
+		return nil
+	}, observableArray...)
+
+}
@@ -0,0 +1,357 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Arinashin3/ari-agent/utils/convert"
+	"github.com/Arinashin3/ari-agent/utils/provider"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkMetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+func init() {
+	moduleName := "pool"
+	registProvider(moduleName, &poolProvider{moduleName: moduleName})
+}
+
+func (pv *poolProvider) IsDefaultEnabled() bool {
+	return true
+}
+
+func (pv *poolProvider) NewProvider(moduleName string, cl *ClientDesc) Provider {
+	pvConf := cfg.Providers.Pool
+	enabled := pvConf.GetEnabled(pv.IsDefaultEnabled())
+	interval := pvConf.GetInterval()
+
+	if !enabled {
+		return nil
+	}
+	if MetricExporter == nil {
+		return nil
+	}
+	mp := provider.NewMeterProvider(serviceName, interval, MetricExporter)
+	return &poolProvider{
+		moduleName:    moduleName,
+		interval:      interval,
+		meterProvider: mp,
+		clientDesc:    cl,
+	}
+}
+
+type poolProvider struct {
+	moduleName    string
+	interval      time.Duration
+	meterProvider *sdkMetric.MeterProvider
+	clientDesc    *ClientDesc
+}
+
+// Health 값: 0: Unknown, 5: Ok, 7: OkBut, 10: Degraded, 15: Minor, 20: Major, 25: Critical, 30: NonRecoverable
+// FAST VP Status 값: 1: Not_Applicable, 2: Paused, 3: Active, 4: Not_Started, 5: Completed, 6: Stopped_By_User, 7: Failed
+var poolMetricDescs = []*provider.MetricDescriptor{
+	{
+		Key:      "health",
+		Name:     "unisphere_pool_health",
+		Desc:     "Health of unisphere pool",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "sizeTotal",
+		Name:     "unisphere_pool_total_capacity",
+		Desc:     "Total capacity of unisphere pool",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "sizeUsed",
+		Name:     "unisphere_pool_used_capacity",
+		Desc:     "Used capacity of unisphere pool",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "sizeFree",
+		Name:     "unisphere_pool_free_capacity",
+		Desc:     "Free capacity of unisphere pool",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "sizeSubscribed",
+		Name:     "unisphere_pool_subscribed_capacity",
+		Desc:     "Subscribed capacity of unisphere pool",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "sizePreallocated",
+		Name:     "unisphere_pool_preallocated_capacity",
+		Desc:     "Preallocated capacity of unisphere pool",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "alertThreshold",
+		Name:     "unisphere_pool_alert_threshold",
+		Desc:     "Used capacity threshold for alerts of unisphere pool",
+		Unit:     "%",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "dataReductionSizeSaved",
+		Name:     "unisphere_pool_data_reduction_saved",
+		Desc:     "Capacity saved by data reduction of unisphere pool",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "dataReductionPercent",
+		Name:     "unisphere_pool_data_reduction_percent",
+		Desc:     "Data reduction percentage of unisphere pool",
+		Unit:     "%",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "dataReductionRatio",
+		Name:     "unisphere_pool_data_reduction_ratio",
+		Desc:     "Data reduction ratio of unisphere pool",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "isFASTCacheEnabled",
+		Name:     "unisphere_pool_fast_cache_enabled",
+		Desc:     "Whether FAST Cache is enabled for unisphere pool",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "tier.sizeTotal",
+		Name:     "unisphere_pool_tier_total_capacity",
+		Desc:     "Total capacity of unisphere pool tier",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "tier.sizeUsed",
+		Name:     "unisphere_pool_tier_used_capacity",
+		Desc:     "Used capacity of unisphere pool tier",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "tier.sizeFree",
+		Name:     "unisphere_pool_tier_free_capacity",
+		Desc:     "Free capacity of unisphere pool tier",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "tier.diskCount",
+		Name:     "unisphere_pool_tier_disk_count",
+		Desc:     "Number of disks in unisphere pool tier",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "fastvp.status",
+		Name:     "unisphere_pool_fastvp_status",
+		Desc:     "FAST VP relocation status of unisphere pool",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "fastvp.percentComplete",
+		Name:     "unisphere_pool_fastvp_progress",
+		Desc:     "FAST VP relocation progress of unisphere pool",
+		Unit:     "%",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "fastvp.sizeMovingUp",
+		Name:     "unisphere_pool_fastvp_moving_up",
+		Desc:     "Capacity scheduled to move to a higher tier of unisphere pool",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "fastvp.sizeMovingDown",
+		Name:     "unisphere_pool_fastvp_moving_down",
+		Desc:     "Capacity scheduled to move to a lower tier of unisphere pool",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "fastvp.sizeMovingWithin",
+		Name:     "unisphere_pool_fastvp_moving_within",
+		Desc:     "Capacity scheduled to move within the same tier of unisphere pool",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "fastvp.dataRelocated",
+		Name:     "unisphere_pool_fastvp_relocated",
+		Desc:     "Capacity relocated by the last FAST VP relocation of unisphere pool",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "poolUnit.health",
+		Name:     "unisphere_pool_unit_health",
+		Desc:     "Health of unisphere pool unit",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "poolUnit.sizeTotal",
+		Name:     "unisphere_pool_unit_total_capacity",
+		Desc:     "Total capacity of unisphere pool unit",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "fastCache.health",
+		Name:     "unisphere_fast_cache_health",
+		Desc:     "Health of unisphere FAST Cache",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "fastCache.sizeTotal",
+		Name:     "unisphere_fast_cache_total_capacity",
+		Desc:     "Total capacity of unisphere FAST Cache",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "fastCache.sizeFree",
+		Name:     "unisphere_fast_cache_free_capacity",
+		Desc:     "Free capacity of unisphere FAST Cache",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+}
+
+func (pv *poolProvider) Run() {
+	logger.Info("Starting provider", "endpoint", pv.clientDesc.endpoint, "provider", pv.moduleName)
+	meter := pv.meterProvider.Meter(pv.moduleName)
+	uc := pv.clientDesc.client
+
+	// Register Metrics...
+	var observableMap map[string]metric.Float64Observable
+	observableMap = provider.CreateMapMetricDescriptor(meter, poolMetricDescs, logger)
+
+	// Register Metrics for Observables...
+	var observableArray []metric.Observable
+	for _, obserable := range observableMap {
+		observableArray = append(observableArray, obserable)
+	}
+
+	// Request Fields
+	var paramsFields = []string{
+		"name", "type", "health", "sizeTotal", "sizeUsed", "sizeFree", "sizeSubscribed", "sizePreallocated", "alertThreshold",
+		"dataReductionSizeSaved", "dataReductionPercent", "dataReductionRatio", "isFASTCacheEnabled", "poolFastVP", "tiers",
+	}
+
+	// Callback
+	meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+
+		// Client Attributes
+		if pv.clientDesc.hostLabels == nil {
+			return errors.New("hostLabels not set")
+		}
+		clientAttrs := metric.WithAttributes(pv.clientDesc.hostLabels...)
+
+		// Request Data
+		data, err := uc.GetPoolInstances(paramsFields, nil)
+		if err != nil {
+			logger.Error("Failed to get pool", "error", err)
+			return nil
+		}
+
+		// Pool Attributes...
+		poolNames := make(map[string]string)
+		for _, entry := range data.Entries {
+			content := entry.Content
+			poolNames[content.Id] = content.Name
+			poolAttrs := metric.WithAttributes(
+				attribute.String("pool.name", content.Name),
+				attribute.String("pool.id", content.Id),
+				attribute.String("pool.type", content.Type.String()),
+			)
+			observer.ObserveFloat64(observableMap["health"], float64(content.Health.Value), clientAttrs, poolAttrs)
+			observer.ObserveFloat64(observableMap["sizeTotal"], content.SizeTotal.ToMiB(), clientAttrs, poolAttrs)
+			observer.ObserveFloat64(observableMap["sizeUsed"], content.SizeUsed.ToMiB(), clientAttrs, poolAttrs)
+			observer.ObserveFloat64(observableMap["sizeFree"], content.SizeFree.ToMiB(), clientAttrs, poolAttrs)
+			observer.ObserveFloat64(observableMap["sizeSubscribed"], content.SizeSubscribed.ToMiB(), clientAttrs, poolAttrs)
+			observer.ObserveFloat64(observableMap["sizePreallocated"], content.SizePreallocated.ToMiB(), clientAttrs, poolAttrs)
+			observer.ObserveFloat64(observableMap["alertThreshold"], float64(content.AlertThreshold), clientAttrs, poolAttrs)
+			observer.ObserveFloat64(observableMap["dataReductionSizeSaved"], content.DataReductionSizeSaved.ToMiB(), clientAttrs, poolAttrs)
+			observer.ObserveFloat64(observableMap["dataReductionPercent"], float64(content.DataReductionPercent), clientAttrs, poolAttrs)
+			observer.ObserveFloat64(observableMap["dataReductionRatio"], content.DataReductionRatio, clientAttrs, poolAttrs)
+			observer.ObserveFloat64(observableMap["isFASTCacheEnabled"], convert.BoolToFloat64(content.IsFASTCacheEnabled), clientAttrs, poolAttrs)
+
+			// Tiers
+			for _, tier := range content.Tiers {
+				// 디스크가 없는 tier 는 제외
+				if tier.SizeTotal == 0 && tier.DiskCount == 0 {
+					continue
+				}
+				tierAttrs := metric.WithAttributes(attribute.String("tier.name", tier.TierType.String()))
+				observer.ObserveFloat64(observableMap["tier.sizeTotal"], tier.SizeTotal.ToMiB(), clientAttrs, poolAttrs, tierAttrs)
+				observer.ObserveFloat64(observableMap["tier.sizeUsed"], tier.SizeUsed.ToMiB(), clientAttrs, poolAttrs, tierAttrs)
+				observer.ObserveFloat64(observableMap["tier.sizeFree"], tier.SizeFree.ToMiB(), clientAttrs, poolAttrs, tierAttrs)
+				observer.ObserveFloat64(observableMap["tier.diskCount"], float64(tier.DiskCount), clientAttrs, poolAttrs, tierAttrs)
+			}
+
+			// FAST VP (Dynamic Pool / All Flash Pool 에서는 제공되지 않음)
+			fastVP := content.PoolFastVP
+			if fastVP.Status == 0 {
+				continue
+			}
+			fastVPAttrs := metric.WithAttributes(attribute.String("fastvp.relocation_rate", fastVP.RelocationRate.String()))
+			observer.ObserveFloat64(observableMap["fastvp.status"], float64(fastVP.Status), clientAttrs, poolAttrs, fastVPAttrs)
+			observer.ObserveFloat64(observableMap["fastvp.percentComplete"], float64(fastVP.PercentComplete), clientAttrs, poolAttrs, fastVPAttrs)
+			observer.ObserveFloat64(observableMap["fastvp.sizeMovingUp"], fastVP.SizeMovingUp.ToMiB(), clientAttrs, poolAttrs, fastVPAttrs)
+			observer.ObserveFloat64(observableMap["fastvp.sizeMovingDown"], fastVP.SizeMovingDown.ToMiB(), clientAttrs, poolAttrs, fastVPAttrs)
+			observer.ObserveFloat64(observableMap["fastvp.sizeMovingWithin"], fastVP.SizeMovingWithin.ToMiB(), clientAttrs, poolAttrs, fastVPAttrs)
+			observer.ObserveFloat64(observableMap["fastvp.dataRelocated"], fastVP.DataRelocated.ToMiB(), clientAttrs, poolAttrs, fastVPAttrs)
+		}
+
+		// Request Data (PoolUnit)
+		unitData, err := uc.GetPoolUnitInstances([]string{"name", "type", "health", "sizeTotal", "tierType", "pool"}, nil)
+		if err != nil {
+			logger.Error("Failed to get poolUnit", "error", err)
+		} else {
+			for _, entry := range unitData.Entries {
+				content := entry.Content
+				unitAttrs := metric.WithAttributes(
+					attribute.String("pool.name", poolNames[content.Pool.Id]),
+					attribute.String("pool.id", content.Pool.Id),
+					attribute.String("pool_unit.name", content.Name),
+					attribute.String("pool_unit.type", content.Type.String()),
+					attribute.String("tier.name", content.TierType.String()),
+				)
+				observer.ObserveFloat64(observableMap["poolUnit.health"], float64(content.Health.Value), clientAttrs, unitAttrs)
+				observer.ObserveFloat64(observableMap["poolUnit.sizeTotal"], content.SizeTotal.ToMiB(), clientAttrs, unitAttrs)
+			}
+		}
+
+		// Request Data (FastCache, Hybrid 모델에서만 제공)
+		cacheData, err := uc.GetFastCacheInstances([]string{"health", "sizeTotal", "sizeFree"}, nil)
+		if err == nil {
+			for _, entry := range cacheData.Entries {
+				content := entry.Content
+				observer.ObserveFloat64(observableMap["fastCache.health"], float64(content.Health.Value), clientAttrs)
+				observer.ObserveFloat64(observableMap["fastCache.sizeTotal"], content.SizeTotal.ToMiB(), clientAttrs)
+				observer.ObserveFloat64(observableMap["fastCache.sizeFree"], content.SizeFree.ToMiB(), clientAttrs)
+			}
+		}
+
+		return nil
+	}, observableArray...)
+
+}
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/Arinashin3/ari-agent/utils/convert"
+	"github.com/Arinashin3/ari-agent/utils/provider"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkMetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+func init() {
+	moduleName := "ports"
+	registProvider(moduleName, &portsProvider{moduleName: moduleName})
+}
+
+func (pv *portsProvider) IsDefaultEnabled() bool {
+	return true
+}
+
+func (pv *portsProvider) NewProvider(moduleName string, cl *ClientDesc) Provider {
+	pvConf := cfg.Providers.Ports
+	enabled := pvConf.GetEnabled(pv.IsDefaultEnabled())
+	interval := pvConf.GetInterval()
+
+	if !enabled {
+		return nil
+	}
+	if MetricExporter == nil {
+		return nil
+	}
+	mp := provider.NewMeterProvider(serviceName, interval, MetricExporter)
+	return &portsProvider{
+		moduleName:    moduleName,
+		interval:      interval,
+		meterProvider: mp,
+		clientDesc:    cl,
+	}
+}
+
+type portsProvider struct {
+	moduleName    string
+	interval      time.Duration
+	meterProvider *sdkMetric.MeterProvider
+	clientDesc    *ClientDesc
+}
+
+// Health 값: 0: Unknown, 5: Ok, 7: OkBut, 10: Degraded, 15: Minor, 20: Major, 25: Critical, 30: NonRecoverable
+var portsMetricDescs = []*provider.MetricDescriptor{
+	{
+		Key:      "fc.health",
+		Name:     "unisphere_port_fc_health",
+		Desc:     "Health of unisphere fibre channel port",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "fc.isLinkUp",
+		Name:     "unisphere_port_fc_link_up",
+		Desc:     "Link state of unisphere fibre channel port",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "fc.speed",
+		Name:     "unisphere_port_fc_speed",
+		Desc:     "Negotiated speed of unisphere fibre channel port",
+		Unit:     "gbps",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "ethernet.health",
+		Name:     "unisphere_port_ethernet_health",
+		Desc:     "Health of unisphere ethernet port",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "ethernet.isLinkUp",
+		Name:     "unisphere_port_ethernet_link_up",
+		Desc:     "Link state of unisphere ethernet port",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "ethernet.speed",
+		Name:     "unisphere_port_ethernet_speed",
+		Desc:     "Negotiated speed of unisphere ethernet port",
+		Unit:     "gbps",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "ethernet.mtu",
+		Name:     "unisphere_port_ethernet_mtu",
+		Desc:     "MTU of unisphere ethernet port",
+		Unit:     "bytes",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "iscsi.isLinkUp",
+		Name:     "unisphere_port_iscsi_link_up",
+		Desc:     "Link state of the ethernet port of unisphere iSCSI portal",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "sas.health",
+		Name:     "unisphere_port_sas_health",
+		Desc:     "Health of unisphere SAS port",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+}
+
+func (pv *portsProvider) Run() {
+	logger.Info("Starting provider", "endpoint", pv.clientDesc.endpoint, "provider", pv.moduleName)
+	meter := pv.meterProvider.Meter(pv.moduleName)
+	uc := pv.clientDesc.client
+
+	// Register Metrics...
+	var observableMap map[string]metric.Float64Observable
+	observableMap = provider.CreateMapMetricDescriptor(meter, portsMetricDescs, logger)
+
+	// Register Metrics for Observables...
+	var observableArray []metric.Observable
+	for _, obserable := range observableMap {
+		observableArray = append(observableArray, obserable)
+	}
+
+	// Callback
+	meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+
+		// Client Attributes
+		if pv.clientDesc.hostLabels == nil {
+			return errors.New("hostLabels not set")
+		}
+		clientAttrs := metric.WithAttributes(pv.clientDesc.hostLabels...)
+
+		// Request Data (FC Port)
+		fcData, err := uc.GetFcPortInstances([]string{"name", "health", "wwn", "currentSpeed", "storageProcessor"}, nil)
+		if err != nil {
+			logger.Error("Failed to get fcPort", "error", err)
+		} else {
+			for _, entry := range fcData.Entries {
+				content := entry.Content
+				fcAttrs := metric.WithAttributes(
+					attribute.String("sp", content.StorageProcessor.Id),
+					attribute.String("port.id", content.Id),
+					attribute.String("port.name", content.Name),
+					attribute.String("port.wwn", content.Wwn),
+				)
+				observer.ObserveFloat64(observableMap["fc.health"], float64(content.Health.Value), clientAttrs, fcAttrs)
+				observer.ObserveFloat64(observableMap["fc.isLinkUp"], convert.BoolToFloat64(content.IsLinkUp()), clientAttrs, fcAttrs)
+				observer.ObserveFloat64(observableMap["fc.speed"], content.SpeedGbps(), clientAttrs, fcAttrs)
+			}
+		}
+
+		// Request Data (Ethernet Port)
+		linkUp := make(map[string]bool)
+		ethData, err := uc.GetEthernetPortInstances([]string{"name", "health", "speed", "mtu", "isLinkUp", "macAddress", "storageProcessor"}, nil)
+		ethOk := err == nil
+		if err != nil {
+			logger.Error("Failed to get ethernetPort", "error", err)
+		} else {
+			for _, entry := range ethData.Entries {
+				content := entry.Content
+				linkUp[content.Id] = content.IsLinkUp
+				ethAttrs := metric.WithAttributes(
+					attribute.String("sp", content.StorageProcessor.Id),
+					attribute.String("port.id", content.Id),
+					attribute.String("port.name", content.Name),
+					attribute.String("port.mac", content.MacAddress),
+				)
+				observer.ObserveFloat64(observableMap["ethernet.health"], float64(content.Health.Value), clientAttrs, ethAttrs)
+				observer.ObserveFloat64(observableMap["ethernet.isLinkUp"], convert.BoolToFloat64(content.IsLinkUp), clientAttrs, ethAttrs)
+				observer.ObserveFloat64(observableMap["ethernet.speed"], content.SpeedGbps(), clientAttrs, ethAttrs)
+				observer.ObserveFloat64(observableMap["ethernet.mtu"], float64(content.Mtu), clientAttrs, ethAttrs)
+			}
+		}
+
+		// Request Data (iSCSI Portal)
+		// link 상태는 ethernetPort 에서 가져오므로, ethernetPort 조회에 실패하면 전송하지 않음
+		portalData, err := uc.GetIscsiPortalInstances([]string{"ethernetPort", "ipAddress", "vlanId"}, nil)
+		if err != nil {
+			logger.Error("Failed to get iscsiPortal", "error", err)
+		} else if ethOk {
+			for _, entry := range portalData.Entries {
+				content := entry.Content
+				up, ok := linkUp[content.EthernetPort.Id]
+				if !ok {
+					continue
+				}
+				portalAttrs := metric.WithAttributes(
+					attribute.String("port.id", content.EthernetPort.Id),
+					attribute.String("ip.address", content.IpAddress),
+					attribute.String("vlan.id", strconv.Itoa(content.VlanId)),
+				)
+				observer.ObserveFloat64(observableMap["iscsi.isLinkUp"], convert.BoolToFloat64(up), clientAttrs, portalAttrs)
+			}
+		}
+
+		// Request Data (SAS Port)
+		sasData, err := uc.GetSasPortInstances([]string{"name", "health", "parentStorageProcessor"}, nil)
+		if err != nil {
+			logger.Error("Failed to get sasPort", "error", err)
+		} else {
+			for _, entry := range sasData.Entries {
+				content := entry.Content
+				sasAttrs := metric.WithAttributes(
+					attribute.String("sp", content.ParentSP.Id),
+					attribute.String("port.id", content.Id),
+					attribute.String("port.name", content.Name),
+				)
+				observer.ObserveFloat64(observableMap["sas.health"], float64(content.Health.Value), clientAttrs, sasAttrs)
+			}
+		}
+
+		return nil
+	}, observableArray...)
+
+}
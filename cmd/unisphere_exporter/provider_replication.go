@@ -0,0 +1,206 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Arinashin3/ari-agent/utils/provider"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkMetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+func init() {
+	moduleName := "replication"
+	registProvider(moduleName, &replicationProvider{moduleName: moduleName})
+}
+
+func (pv *replicationProvider) IsDefaultEnabled() bool {
+	return true
+}
+
+func (pv *replicationProvider) NewProvider(moduleName string, cl *ClientDesc) Provider {
+	pvConf := cfg.Providers.Replication
+	enabled := pvConf.GetEnabled(pv.IsDefaultEnabled())
+	interval := pvConf.GetInterval()
+
+	if !enabled {
+		return nil
+	}
+	if MetricExporter == nil {
+		return nil
+	}
+	mp := provider.NewMeterProvider(serviceName, interval, MetricExporter)
+	return &replicationProvider{
+		moduleName:    moduleName,
+		interval:      interval,
+		meterProvider: mp,
+		clientDesc:    cl,
+	}
+}
+
+type replicationProvider struct {
+	moduleName    string
+	interval      time.Duration
+	meterProvider *sdkMetric.MeterProvider
+	clientDesc    *ClientDesc
+}
+
+// Health 값: 0: Unknown, 5: Ok, 7: OkBut, 10: Degraded, 15: Minor, 20: Major, 25: Critical, 30: NonRecoverable
+// Sync State 값: 0: Manual_Syncing, 1: Auto_Syncing, 2: Idle_Manual_Sync, 3: Idle_Auto_Sync, 4: Out_Of_Sync,
+// 5: In_Sync, 6: Consistent, 7: Syncing, 8: Inconsistent
+var replicationMetricDescs = []*provider.MetricDescriptor{
+	{
+		Key:      "health",
+		Name:     "unisphere_replication_health",
+		Desc:     "Health of unisphere replication session",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "status",
+		Name:     "unisphere_replication_status",
+		Desc:     "Operational status code (ReplicationOpStatusEnum) of unisphere replication session",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "syncState",
+		Name:     "unisphere_replication_sync_state",
+		Desc:     "Synchronization state of unisphere replication session",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "lastSyncTime",
+		Name:     "unisphere_replication_last_sync_time",
+		Desc:     "Last synchronization time of unisphere replication session",
+		Unit:     "timestamp",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "lastSyncAge",
+		Name:     "unisphere_replication_last_sync_age",
+		Desc:     "Time elapsed since the last synchronization of unisphere replication session",
+		Unit:     "s",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "maxTimeOutOfSync",
+		Name:     "unisphere_replication_rpo",
+		Desc:     "Configured RPO of unisphere asynchronous replication session",
+		Unit:     "s",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "syncProgress",
+		Name:     "unisphere_replication_sync_progress",
+		Desc:     "Progress of the current synchronization of unisphere replication session",
+		Unit:     "%",
+		TypeName: "gauge",
+	},
+	// 남은 전송 용량(bytes)은 API 에서 제공하지 않으므로 예상 남은 시간으로 대체
+	{
+		Key:      "currentTransferEstRemainTime",
+		Name:     "unisphere_replication_transfer_remaining_time",
+		Desc:     "Estimated time remaining for the current transfer of unisphere replication session",
+		Unit:     "s",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "remoteSystem.health",
+		Name:     "unisphere_remote_system_health",
+		Desc:     "Health of unisphere remote system",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+}
+
+func (pv *replicationProvider) Run() {
+	logger.Info("Starting provider", "endpoint", pv.clientDesc.endpoint, "provider", pv.moduleName)
+	meter := pv.meterProvider.Meter(pv.moduleName)
+	uc := pv.clientDesc.client
+
+	// Register Metrics...
+	var observableMap map[string]metric.Float64Observable
+	observableMap = provider.CreateMapMetricDescriptor(meter, replicationMetricDescs, logger)
+
+	// Register Metrics for Observables...
+	var observableArray []metric.Observable
+	for _, obserable := range observableMap {
+		observableArray = append(observableArray, obserable)
+	}
+
+	// Request Fields
+	var paramsFields = []string{
+		"name", "health", "replicationResourceType", "status", "syncState", "localRole", "maxTimeOutOfSync",
+		"lastSyncTime", "syncProgress", "currentTransferEstRemainTime", "srcResourceId", "dstResourceId", "remoteSystem",
+	}
+
+	// Callback
+	meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+
+		// Client Attributes
+		if pv.clientDesc.hostLabels == nil {
+			return errors.New("hostLabels not set")
+		}
+		clientAttrs := metric.WithAttributes(pv.clientDesc.hostLabels...)
+
+		// Request Data (RemoteSystem)
+		remoteNames := make(map[string]string)
+		remoteData, err := uc.GetRemoteSystemInstances([]string{"name", "health", "model", "serialNumber", "managementAddress"}, nil)
+		if err != nil {
+			logger.Error("Failed to get remoteSystem", "error", err)
+		} else {
+			for _, entry := range remoteData.Entries {
+				content := entry.Content
+				remoteNames[content.Id] = content.Name
+				remoteAttrs := metric.WithAttributes(
+					attribute.String("remote.name", content.Name),
+					attribute.String("remote.serial", content.SerialNumber),
+					attribute.String("remote.model", content.Model),
+					attribute.String("remote.address", content.ManagementAddress),
+				)
+				observer.ObserveFloat64(observableMap["remoteSystem.health"], float64(content.Health.Value), clientAttrs, remoteAttrs)
+			}
+		}
+
+		// Request Data (ReplicationSession)
+		data, err := uc.GetReplicationSessionInstances(paramsFields, nil)
+		if err != nil {
+			logger.Error("Failed to get replicationSession", "error", err)
+			return nil
+		}
+
+		now := time.Now()
+		for _, entry := range data.Entries {
+			content := entry.Content
+			sessionAttrs := metric.WithAttributes(
+				attribute.String("session.name", content.Name),
+				attribute.String("session.id", content.Id),
+				attribute.String("resource.type", content.ReplicationResourceType.String()),
+				attribute.String("replication.role", content.LocalRole.String()),
+				attribute.String("source.resource", content.SrcResourceId),
+				attribute.String("destination.resource", content.DstResourceId),
+				attribute.String("remote.name", remoteNames[content.RemoteSystem.Id]),
+			)
+			observer.ObserveFloat64(observableMap["health"], float64(content.Health.Value), clientAttrs, sessionAttrs)
+			observer.ObserveFloat64(observableMap["status"], float64(content.Status), clientAttrs, sessionAttrs)
+			observer.ObserveFloat64(observableMap["syncState"], float64(content.SyncState), clientAttrs, sessionAttrs)
+			observer.ObserveFloat64(observableMap["syncProgress"], float64(content.SyncProgress), clientAttrs, sessionAttrs)
+			observer.ObserveFloat64(observableMap["currentTransferEstRemainTime"], float64(content.CurrentTransferEstRemainTime), clientAttrs, sessionAttrs)
+			if !content.LastSyncTime.IsZero() {
+				observer.ObserveFloat64(observableMap["lastSyncTime"], float64(content.LastSyncTime.Unix()), clientAttrs, sessionAttrs)
+				observer.ObserveFloat64(observableMap["lastSyncAge"], now.Sub(content.LastSyncTime).Seconds(), clientAttrs, sessionAttrs)
+			}
+			// maxTimeOutOfSync (분) => -1: 동기 복제, 0: 수동 동기화
+			if content.MaxTimeOutOfSync > 0 {
+				observer.ObserveFloat64(observableMap["maxTimeOutOfSync"], float64(content.MaxTimeOutOfSync*60), clientAttrs, sessionAttrs)
+			}
+		}
+
+		return nil
+	}, observableArray...)
+
+}
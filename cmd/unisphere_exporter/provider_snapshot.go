@@ -0,0 +1,186 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Arinashin3/ari-agent/client/unisphere"
+	"github.com/Arinashin3/ari-agent/utils/convert"
+	"github.com/Arinashin3/ari-agent/utils/provider"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkMetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+func init() {
+	moduleName := "snapshot"
+	registProvider(moduleName, &snapshotProvider{moduleName: moduleName})
+}
+
+func (pv *snapshotProvider) IsDefaultEnabled() bool {
+	return false
+}
+
+func (pv *snapshotProvider) NewProvider(moduleName string, cl *ClientDesc) Provider {
+	pvConf := cfg.Providers.Snapshot
+	enabled := pvConf.GetEnabled(pv.IsDefaultEnabled())
+	interval := pvConf.GetInterval()
+
+	if !enabled {
+		return nil
+	}
+	if MetricExporter == nil {
+		return nil
+	}
+	mp := provider.NewMeterProvider(serviceName, interval, MetricExporter)
+	return &snapshotProvider{
+		moduleName:    moduleName,
+		interval:      interval,
+		meterProvider: mp,
+		clientDesc:    cl,
+	}
+}
+
+type snapshotProvider struct {
+	moduleName    string
+	interval      time.Duration
+	meterProvider *sdkMetric.MeterProvider
+	clientDesc    *ClientDesc
+}
+
+var snapshotMetricDescs = []*provider.MetricDescriptor{
+	{
+		Key:      "count",
+		Name:     "unisphere_snapshot_count",
+		Desc:     "Number of snapshots of unisphere storage resource",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "oldestAge",
+		Name:     "unisphere_snapshot_oldest_age",
+		Desc:     "Age of the oldest snapshot of unisphere storage resource",
+		Unit:     "s",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "newestAge",
+		Name:     "unisphere_snapshot_newest_age",
+		Desc:     "Age of the newest snapshot of unisphere storage resource",
+		Unit:     "s",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "snapsSizeAllocated",
+		Name:     "unisphere_snapshot_size_allocated",
+		Desc:     "Space allocated to snapshots of unisphere storage resource",
+		Unit:     "mb",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "scheduleAttached",
+		Name:     "unisphere_snapshot_schedule_attached",
+		Desc:     "Whether a snapshot schedule is attached to unisphere storage resource",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+	{
+		Key:      "schedulePaused",
+		Name:     "unisphere_snapshot_schedule_paused",
+		Desc:     "Whether the snapshot schedule of unisphere storage resource is paused",
+		Unit:     "",
+		TypeName: "gauge",
+	},
+}
+
+func (pv *snapshotProvider) Run() {
+	logger.Info("Starting provider", "endpoint", pv.clientDesc.endpoint, "provider", pv.moduleName)
+	meter := pv.meterProvider.Meter(pv.moduleName)
+	uc := pv.clientDesc.client
+
+	// Register Metrics...
+	var observableMap map[string]metric.Float64Observable
+	observableMap = provider.CreateMapMetricDescriptor(meter, snapshotMetricDescs, logger)
+
+	// Register Metrics for Observables...
+	var observableArray []metric.Observable
+	for _, obserable := range observableMap {
+		observableArray = append(observableArray, obserable)
+	}
+
+	// Callback
+	meter.RegisterCallback(func(ctx context.Context, observer metric.Observer) error {
+
+		// Client Attributes
+		if pv.clientDesc.hostLabels == nil {
+			return errors.New("hostLabels not set")
+		}
+		clientAttrs := metric.WithAttributes(pv.clientDesc.hostLabels...)
+
+		// Request Data (StorageResource)
+		data, err := uc.GetStorageResourceInstances([]string{"name", "type", "snapSchedule", "isSnapSchedulePaused", "snapsSizeAllocated"}, nil)
+		if err != nil {
+			logger.Error("Failed to get storageResource", "error", err)
+			return nil
+		}
+
+		// Request Data (SnapSchedule)
+		scheduleNames := make(map[string]string)
+		scheduleData, err := uc.GetSnapScheduleInstances([]string{"name"}, nil)
+		if err != nil {
+			logger.Error("Failed to get snapSchedule", "error", err)
+		} else {
+			for _, entry := range scheduleData.Entries {
+				scheduleNames[entry.Content.Id] = entry.Content.Name
+			}
+		}
+
+		// Request Data (Snap, 복제 등에 사용되는 system snapshot 은 제외)
+		snapData, err := uc.GetSnapInstances([]string{"storageResource", "creationTime"}, unisphere.NewFilter().Eq("isSystemSnap", false))
+		if err != nil {
+			logger.Error("Failed to get snap", "error", err)
+			return nil
+		}
+		now := time.Now()
+		snapCount := make(map[string]float64)
+		oldest := make(map[string]time.Time)
+		newest := make(map[string]time.Time)
+		for _, entry := range snapData.Entries {
+			content := entry.Content
+			resId := content.StorageResource.Id
+			snapCount[resId]++
+			if t, ok := oldest[resId]; !ok || content.CreationTime.Before(t) {
+				oldest[resId] = content.CreationTime
+			}
+			if t, ok := newest[resId]; !ok || content.CreationTime.After(t) {
+				newest[resId] = content.CreationTime
+			}
+		}
+
+		// StorageResource Attributes...
+		for _, entry := range data.Entries {
+			content := entry.Content
+			resAttrs := metric.WithAttributes(
+				attribute.String("resource.name", content.Name),
+				attribute.String("resource.id", content.Id),
+				attribute.String("resource.type", content.Type.String()),
+				attribute.String("schedule.name", scheduleNames[content.SnapSchedule.Id]),
+			)
+			observer.ObserveFloat64(observableMap["count"], snapCount[content.Id], clientAttrs, resAttrs)
+			// snap.size 는 snapshot 생성 시점의 원본 크기이므로, 실제 사용량은 storageResource 의 값을 사용
+			observer.ObserveFloat64(observableMap["snapsSizeAllocated"], content.SnapsSizeAllocated.ToMiB(), clientAttrs, resAttrs)
+			observer.ObserveFloat64(observableMap["scheduleAttached"], convert.BoolToFloat64(content.SnapSchedule.Id != ""), clientAttrs, resAttrs)
+			observer.ObserveFloat64(observableMap["schedulePaused"], convert.BoolToFloat64(content.IsSnapSchedulePaused), clientAttrs, resAttrs)
+			if t, ok := oldest[content.Id]; ok {
+				observer.ObserveFloat64(observableMap["oldestAge"], now.Sub(t).Seconds(), clientAttrs, resAttrs)
+			}
+			if t, ok := newest[content.Id]; ok {
+				observer.ObserveFloat64(observableMap["newestAge"], now.Sub(t).Seconds(), clientAttrs, resAttrs)
+			}
+		}
+
+		return nil
+	}, observableArray...)
+
+}
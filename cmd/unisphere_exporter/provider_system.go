@@ -94,7 +94,7 @@ func (pv *systemProvider) Run() {
 			ipaddr = content.IpAddress
 		}
 		// Request Data (BasicSystemInfo)
-		data, err := uc.GetBasicSystemInfoInstances()
+		data, err := uc.GetBasicSystemInfoInstances(nil, nil)
 		if err != nil {
 			logger.Error("Failed to get system", "error", err)
 			return nil
@@ -22,10 +22,22 @@ type SpectrumConfig struct {
 }
 
 type SpectrumProviders struct {
-	System      *config.CommonProviderDefaults `yaml: "system,omitempty"`
-	Performance *config.CommonProviderDefaults `yaml: "performance,omitempty"`
-	Event       *config.CommonProviderDefaults `yaml: "event,omitempty"`
-	Flashcopy   *config.CommonProviderDefaults `yaml: "flashcopy,omitempty"`
+	System          *config.CommonProviderDefaults `yaml: "system,omitempty"`
+	Performance     *config.CommonProviderDefaults `yaml: "performance,omitempty"`
+	Event           *config.CommonProviderDefaults `yaml: "event,omitempty"`
+	Flashcopy       *config.CommonProviderDefaults `yaml: "flashcopy,omitempty"`
+	Volume          *config.CommonProviderDefaults `yaml:"volume,omitempty"`
+	Pool            *config.CommonProviderDefaults `yaml:"pool,omitempty"`
+	Hardware        *config.CommonProviderDefaults `yaml:"hardware,omitempty"`
+	Replication     *config.CommonProviderDefaults `yaml:"replication,omitempty"`
+	Iostats         *config.CommonProviderDefaults `yaml:"iostats,omitempty"`
+	Ports           *config.CommonProviderDefaults `yaml:"ports,omitempty"`
+	Host            *config.CommonProviderDefaults `yaml:"host,omitempty"`
+	Snapshot        *config.CommonProviderDefaults `yaml:"snapshot,omitempty"`
+	Audit           *config.CommonProviderDefaults `yaml:"audit,omitempty"`
+	NodePerformance *config.CommonProviderDefaults `yaml:"node_performance,omitempty"`
+	Topology        *config.CommonProviderDefaults `yaml:"topology,omitempty"`
+	Array           *config.CommonProviderDefaults `yaml:"array,omitempty"`
 }
 
 func NewSpectrumConfiguration() *SpectrumConfig {
@@ -59,10 +71,22 @@ func NewSpectrumConfiguration() *SpectrumConfig {
 		Clients: nil,
 		Auths:   nil,
 		Providers: &SpectrumProviders{
-			System:      &config.CommonProviderDefaults{},
-			Performance: &config.CommonProviderDefaults{},
-			Event:       &config.CommonProviderDefaults{},
-			Flashcopy:   &config.CommonProviderDefaults{},
+			System:          &config.CommonProviderDefaults{},
+			Performance:     &config.CommonProviderDefaults{},
+			Event:           &config.CommonProviderDefaults{},
+			Flashcopy:       &config.CommonProviderDefaults{},
+			Volume:          &config.CommonProviderDefaults{},
+			Pool:            &config.CommonProviderDefaults{},
+			Hardware:        &config.CommonProviderDefaults{},
+			Replication:     &config.CommonProviderDefaults{},
+			Iostats:         &config.CommonProviderDefaults{},
+			Ports:           &config.CommonProviderDefaults{},
+			Host:            &config.CommonProviderDefaults{},
+			Snapshot:        &config.CommonProviderDefaults{},
+			Audit:           &config.CommonProviderDefaults{},
+			NodePerformance: &config.CommonProviderDefaults{},
+			Topology:        &config.CommonProviderDefaults{},
+			Array:           &config.CommonProviderDefaults{},
 		},
 	}
 }
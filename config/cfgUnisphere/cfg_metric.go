@@ -9,6 +9,8 @@ type UnisphereProviderMetric struct {
 	Enabled  string   `yaml:"enabled,omitempty"`
 	Paths    []string `yaml:"paths,omitempty"`
 	Interval string   `yaml:"interval,omitempty"`
+	// 수집이 끊긴 구간을 이력(historical) 메트릭으로 채울 최대 기간 (비어있으면 사용하지 않음)
+	Backfill string `yaml:"backfill,omitempty"`
 }
 
 func (pv *UnisphereProviderMetric) GetEnabled(defaults bool) bool {
@@ -23,3 +25,8 @@ func (pv *UnisphereProviderMetric) GetInterval() time.Duration {
 	interval, _ := time.ParseDuration(pv.Interval)
 	return interval
 }
+
+func (pv *UnisphereProviderMetric) GetBackfill() time.Duration {
+	backfill, _ := time.ParseDuration(pv.Backfill)
+	return backfill
+}
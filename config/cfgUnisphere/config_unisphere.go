@@ -22,13 +22,25 @@ type UnisphereConfig struct {
 }
 
 type UnisphereProviders struct {
-	System   *config.CommonProviderDefaults `yaml:"system,omitempty"`
-	Lun      *config.CommonProviderDefaults `yaml:"lun,omitempty"`
-	Capacity *config.CommonProviderDefaults `yaml:"capacity,omitempty"`
-	Metric_A *UnisphereProviderMetric       `yaml:"metric_a,omitempty"`
-	Metric_B *UnisphereProviderMetric       `yaml:"metric_b,omitempty"`
-	Metric_C *UnisphereProviderMetric       `yaml:"metric_c,omitempty"`
-	Event    *UnisphereProviderEvent        `yaml:"event,omitempty"`
+	System      *config.CommonProviderDefaults      `yaml:"system,omitempty"`
+	Lun         *config.CommonProviderDefaults      `yaml:"lun,omitempty"`
+	Capacity    *config.CommonProviderDefaults      `yaml:"capacity,omitempty"`
+	Pool        *config.CommonProviderDefaults      `yaml:"pool,omitempty"`
+	Filesystem  *config.CommonProviderDefaults      `yaml:"filesystem,omitempty"`
+	Nas         *config.CommonProviderDefaults      `yaml:"nas,omitempty"`
+	Hardware    *config.CommonProviderDefaults      `yaml:"hardware,omitempty"`
+	Replication *config.CommonProviderDefaults      `yaml:"replication,omitempty"`
+	Snapshot    *config.CommonProviderDefaults      `yaml:"snapshot,omitempty"`
+	Host        *config.CommonProviderDefaults      `yaml:"host,omitempty"`
+	Ports       *config.CommonProviderDefaults      `yaml:"ports,omitempty"`
+	Alert       *config.CommonProviderDefaults      `yaml:"alert,omitempty"`
+	Metrics     map[string]*UnisphereProviderMetric `yaml:"metrics,omitempty"`
+	Event       *UnisphereProviderEvent             `yaml:"event,omitempty"`
+
+	// Deprecated: metrics 의 그룹으로 옮겨서 사용 (기존 설정 호환용)
+	Metric_A *UnisphereProviderMetric `yaml:"metric_a,omitempty"`
+	Metric_B *UnisphereProviderMetric `yaml:"metric_b,omitempty"`
+	Metric_C *UnisphereProviderMetric `yaml:"metric_c,omitempty"`
 }
 
 func NewUnisphereConfiguration() *UnisphereConfig {
@@ -62,12 +74,18 @@ func NewUnisphereConfiguration() *UnisphereConfig {
 		Clients: nil,
 		Auths:   nil,
 		Providers: &UnisphereProviders{
-			System:   &config.CommonProviderDefaults{},
-			Lun:      &config.CommonProviderDefaults{},
-			Capacity: &config.CommonProviderDefaults{},
-			Metric_A: &UnisphereProviderMetric{},
-			Metric_B: &UnisphereProviderMetric{},
-			Metric_C: &UnisphereProviderMetric{},
+			System:      &config.CommonProviderDefaults{},
+			Lun:         &config.CommonProviderDefaults{},
+			Capacity:    &config.CommonProviderDefaults{},
+			Pool:        &config.CommonProviderDefaults{},
+			Filesystem:  &config.CommonProviderDefaults{},
+			Nas:         &config.CommonProviderDefaults{},
+			Hardware:    &config.CommonProviderDefaults{},
+			Replication: &config.CommonProviderDefaults{},
+			Snapshot:    &config.CommonProviderDefaults{},
+			Host:        &config.CommonProviderDefaults{},
+			Ports:       &config.CommonProviderDefaults{},
+			Alert:       &config.CommonProviderDefaults{},
 			Event: &UnisphereProviderEvent{
 				Level: 5,
 			},
@@ -146,19 +164,40 @@ func (cfg *UnisphereConfig) applyGlobal() error {
 	if err != nil {
 		return err
 	}
+	err = cfg.Providers.migrateLegacyMetrics()
+	if err != nil {
+		return err
+	}
 	// At the Providers, if value of field is null, then Apply Global
 	pvNum := reflect.ValueOf(cfg.Providers).Elem().NumField()
 	for i := 0; i < pvNum; i++ {
-		pv := reflect.ValueOf(cfg.Providers).Elem().Field(i).Elem()
+		field := reflect.ValueOf(cfg.Providers).Elem().Field(i)
 
-		// Apply Global Interval
-		interval := pv.FieldByName("Interval")
-		if interval.String() == "" {
-			interval.SetString(cfg.Global.Provider.Interval)
+		// Metrics 와 같이 이름별로 정의된 Provider 는 각각 적용
+		var pvs []reflect.Value
+		if field.Kind() == reflect.Map {
+			for _, key := range field.MapKeys() {
+				pvs = append(pvs, field.MapIndex(key))
+			}
 		} else {
-			_, err = time.ParseDuration(interval.String())
-			if err != nil {
-				return err
+			pvs = append(pvs, field)
+		}
+
+		for _, pv := range pvs {
+			if pv.IsNil() {
+				continue
+			}
+			pv = pv.Elem()
+
+			// Apply Global Interval
+			interval := pv.FieldByName("Interval")
+			if interval.String() == "" {
+				interval.SetString(cfg.Global.Provider.Interval)
+			} else {
+				_, err = time.ParseDuration(interval.String())
+				if err != nil {
+					return err
+				}
 			}
 		}
 	}
@@ -166,6 +205,30 @@ func (cfg *UnisphereConfig) applyGlobal() error {
 	return nil
 }
 
+// migrateLegacyMetrics
+// 기존 metric_a / metric_b / metric_c 설정을 같은 이름의 metrics 그룹으로 옮김
+func (pvs *UnisphereProviders) migrateLegacyMetrics() error {
+	legacy := map[string]**UnisphereProviderMetric{
+		"metric_a": &pvs.Metric_A,
+		"metric_b": &pvs.Metric_B,
+		"metric_c": &pvs.Metric_C,
+	}
+	for name, m := range legacy {
+		if *m == nil {
+			continue
+		}
+		if pvs.Metrics == nil {
+			pvs.Metrics = make(map[string]*UnisphereProviderMetric)
+		}
+		if pvs.Metrics[name] != nil {
+			return errors.New("providers." + name + " is duplicated in providers.metrics")
+		}
+		pvs.Metrics[name] = *m
+		*m = nil
+	}
+	return nil
+}
+
 // SearchAuth
 // 인증정보를 찾아, base64로 인코딩하여 리턴합니다.
 func (cfg *UnisphereConfig) SearchAuth(name string) (string, string) {
@@ -3,7 +3,6 @@ module github.com/Arinashin3/ari-agent
 go 1.25.0
 
 require (
-	github.com/Arinashin3/gounity v0.0.0-20251001103124-b4fb84649972
 	github.com/alecthomas/kingpin/v2 v2.4.0
 	github.com/alecthomas/units v0.0.0-20240927000941-0f3dac36c52b
 	github.com/prometheus/common v0.66.1
@@ -71,3 +71,12 @@ func UnitConvert[T int | int64 | float64](v T, toUnit string) float64 {
 	}
 	return -1
 }
+
+// BoolToFloat64
+// true: 1, false: 0
+func BoolToFloat64(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
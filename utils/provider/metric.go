@@ -11,9 +11,15 @@ import (
 	"go.opentelemetry.io/otel/sdk/resource"
 )
 
+// NewResource
+// MeterProvider 를 거치지 않고 Exporter 로 직접 전송하는 경우에도 같은 resource 를 사용하도록 합니다.
+func NewResource(svName string) *resource.Resource {
+	return resource.NewSchemaless(attribute.String("service.name", svName))
+}
+
 func NewMeterProvider(svName string, interval time.Duration, exp *sdkMetric.Exporter) *sdkMetric.MeterProvider {
 	return sdkMetric.NewMeterProvider(
-		sdkMetric.WithResource(resource.NewSchemaless(attribute.String("service.name", svName))),
+		sdkMetric.WithResource(NewResource(svName)),
 		sdkMetric.WithReader(
 			sdkMetric.NewPeriodicReader(*exp,
 				sdkMetric.WithInterval(interval),